@@ -0,0 +1,156 @@
+// Command liqigen converts liqi.json, the protocol descriptor of the Majsoul client, to liqi.proto, liqi.pb.go and
+// liqi_grpc.pb.go. liqi.pb.go is generated by the released protoc-gen-go, run with go run unless -protoc_gen_go is set,
+// no protoc is needed.
+//
+// It is run by go generate in the message package:
+//
+//	go generate ./message
+//
+// To update the protocol to the one of the live client, download liqi.json first:
+//
+//	go run ./cmd/liqigen -server https://game.maj-soul.com -json proto/liqi.json -proto proto/liqi.proto -go_out message
+//
+// The rpcs, messages and fields added or removed since the previous liqi.json are printed,
+// the version of the downloaded client is written to version.go, it is majsoul.ProtoVersion.
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/constellation39/majsoul/liqi"
+	"github.com/constellation39/majsoul/network"
+	"github.com/constellation39/majsoul/resources"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const protoName = "liqi.proto" // Name of the descriptor, as recorded in the generated Go code
+
+func main() {
+	jsonPath := flag.String("json", "liqi.json", "liqi.json to convert, replaced by the downloaded one with -server")
+	server := flag.String("server", "", "download the liqi.json of the live client of this server, e.g. https://game.maj-soul.com")
+	cache := flag.String("cache", "", "cache directory of downloaded resources")
+	oldPath := flag.String("old", "", "liqi.json to compare with, defaults to the one replaced by -server")
+	protoPath := flag.String("proto", "", "write the .proto source to this file")
+	goOut := flag.String("go_out", "", "write liqi.pb.go and liqi_grpc.pb.go to this directory")
+	protocGenGo := flag.String("protoc_gen_go", "go run google.golang.org/protobuf/cmd/protoc-gen-go", "command of the protoc-gen-go plugin generating liqi.pb.go, by default the one of the protobuf module in use")
+	goPackage := flag.String("go_package", "github.com/constellation39/majsoul/message;message", "import path and name of the Go package")
+	version := flag.String("version", "", "client version of liqi.json, written to version.go with -go_out, defaults to the version downloaded with -server")
+	flag.Parse()
+	log.SetFlags(0)
+
+	previous, err := os.ReadFile(*jsonPath)
+	if err != nil && !(errors.Is(err, os.ErrNotExist) && len(*server) != 0) {
+		log.Fatal(err)
+	}
+	current := previous
+	if len(*server) != 0 {
+		var downloaded string
+		if current, downloaded, err = download(*server, *cache); err != nil {
+			log.Fatal(err)
+		}
+		if len(*version) == 0 {
+			*version = downloaded
+		}
+		if err = os.WriteFile(*jsonPath, current, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if len(*oldPath) != 0 {
+		if previous, err = os.ReadFile(*oldPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	file, err := liqi.Parse(current)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(previous) != 0 && !bytes.Equal(previous, current) {
+		old, err := liqi.Parse(previous)
+		if err != nil {
+			log.Fatal(fmt.Errorf("previous %w", err))
+		}
+		if diff := file.Compare(old); diff.Empty() {
+			fmt.Println("no rpc, message or field changed")
+		} else {
+			fmt.Print(diff)
+		}
+	}
+
+	if len(*protoPath) != 0 {
+		if err = os.WriteFile(*protoPath, file.Proto(), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if len(*goOut) != 0 {
+		fd, err := file.Descriptor(protoName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		code, err := liqi.GenerateGo(ctx, fd, *goPackage, strings.Fields(*protocGenGo))
+		cancel()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(filepath.Join(*goOut, "liqi.pb.go"), code, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		if code, err = liqi.GenerateGrpc(fd, *goPackage); err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(filepath.Join(*goOut, "liqi_grpc.pb.go"), code, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		// without -server or -version, version.go is left as written along with the liqi.json in use
+		if len(*version) != 0 {
+			if err = os.WriteFile(filepath.Join(*goOut, "version.go"), versionSource(*goPackage, *version), 0o644); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
+
+// versionSource returns the Go code declaring ProtoVersion as version in the package named by goPackage.
+func versionSource(goPackage, version string) []byte {
+	name := goPackage
+	if i := strings.LastIndexAny(name, ";/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return []byte(fmt.Sprintf(`// Code generated by liqigen. DO NOT EDIT.
+
+package %s
+
+// ProtoVersion is the version of the client liqi.json was downloaded from.
+const ProtoVersion = %q
+`, name, version))
+}
+
+// download fetches the liqi.json of the version currently published by server, and returns it with that version.
+func download(server, cache string) ([]byte, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	header := http.Header{}
+	header.Set("User-Agent", network.UserAgent)
+	request := network.NewRequest(server, header, http.Client{})
+	downloader := resources.NewDownloader(request, cache)
+	manifest, err := downloader.Latest(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := downloader.Liqi(ctx, manifest)
+	if err != nil {
+		return nil, "", err
+	}
+	prefix, _ := manifest.Prefix(resources.LiqiPath)
+	log.Printf("liqi.json %s of client %s", prefix, manifest.Version)
+	return data, manifest.Version, nil
+}
@@ -0,0 +1,413 @@
+// Package config decodes lqc.lqbin, the config tables of the Majsoul client, see resources.ConfigTablesPath.
+//
+// The file is a protobuf message lq.config.ConfigTables:
+//
+//	message ConfigTables { string version = 1; string header_hash = 2; repeated TableSchema schemas = 3; repeated TableData datas = 4; }
+//	message TableSchema { string name = 1; repeated SheetSchema sheets = 2; }
+//	message SheetSchema { string name = 1; repeated FieldSchema fields = 2; }
+//	message FieldSchema { string field_name = 1; uint32 array_length = 2; string pb_type = 3; uint32 pb_index = 4; }
+//	message TableData { string table = 1; string sheet = 2; repeated bytes data = 3; }
+//
+// Each data of a TableData is a row, a protobuf message whose fields are described by the schema of its sheet.
+package config
+
+import (
+	"fmt"
+	"google.golang.org/protobuf/encoding/protowire"
+	"math"
+	"os"
+)
+
+// FieldSchema is a column of a sheet, the protobuf field holding it in each row.
+type FieldSchema struct {
+	Name        string // field_name
+	ArrayLength uint32 // array_length, greater than 0 for repeated columns
+	Type        string // pb_type, e.g. "uint32" or "string"
+	Index       uint32 // pb_index, the number of the protobuf field
+}
+
+// SheetSchema is the schema of a sheet.
+type SheetSchema struct {
+	Name   string
+	Fields []FieldSchema
+}
+
+// TableSchema is the schema of a table and its sheets.
+type TableSchema struct {
+	Name   string
+	Sheets []SheetSchema
+}
+
+// Tables is a decoded lqc.lqbin.
+type Tables struct {
+	Version    string
+	HeaderHash string
+	Schemas    []TableSchema
+	sheets     map[string]*Sheet // Keyed by sheetKey
+}
+
+// Sheet is the content of a sheet.
+type Sheet struct {
+	Table  string
+	Name   string
+	Schema *SheetSchema
+	Rows   []Row
+	ids    map[uint32]int // Index in Rows keyed by the id column, nil if there is none
+}
+
+// Row is a row of a sheet keyed by column name.
+// Values are uint32, int32, uint64, int64, bool, float32, float64, string or []byte, a slice of them for repeated columns.
+type Row map[string]interface{}
+
+// Load decodes the lqc.lqbin file at path.
+func Load(path string) (*Tables, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// Decode decodes the content of lqc.lqbin.
+func Decode(data []byte) (*Tables, error) {
+	tables := &Tables{
+		Version:    "",
+		HeaderHash: "",
+		Schemas:    nil,
+		sheets:     make(map[string]*Sheet),
+	}
+	var datas [][]byte
+	err := consumeFields(data, func(num protowire.Number, typ protowire.Type, _ uint64, b []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			tables.Version = string(b)
+		case num == 2 && typ == protowire.BytesType:
+			tables.HeaderHash = string(b)
+		case num == 3 && typ == protowire.BytesType:
+			schema, err := decodeTableSchema(b)
+			if err != nil {
+				return fmt.Errorf("schema: %w", err)
+			}
+			tables.Schemas = append(tables.Schemas, schema)
+		case num == 4 && typ == protowire.BytesType:
+			datas = append(datas, b)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("decode config tables error: %w", err)
+	}
+
+	schemas := make(map[string]*SheetSchema)
+	for i := range tables.Schemas {
+		table := &tables.Schemas[i]
+		for j := range table.Sheets {
+			schemas[sheetKey(table.Name, table.Sheets[j].Name)] = &table.Sheets[j]
+		}
+	}
+	for _, b := range datas {
+		sheet, err := decodeTableData(b, schemas)
+		if err != nil {
+			return nil, fmt.Errorf("decode config tables error: %w", err)
+		}
+		if sheet != nil {
+			tables.sheets[sheetKey(sheet.Table, sheet.Name)] = sheet
+		}
+	}
+	return tables, nil
+}
+
+func sheetKey(table, sheet string) string {
+	return fmt.Sprintf("%s.%s", table, sheet)
+}
+
+// Sheet returns the sheet of a table, e.g. Sheet("item_definition", "character").
+func (tables *Tables) Sheet(table, sheet string) (*Sheet, bool) {
+	s, ok := tables.sheets[sheetKey(table, sheet)]
+	return s, ok
+}
+
+// Find returns the row whose id column is id.
+func (sheet *Sheet) Find(id uint32) (Row, bool) {
+	if sheet == nil || sheet.ids == nil {
+		return nil, false
+	}
+	i, ok := sheet.ids[id]
+	if !ok {
+		return nil, false
+	}
+	return sheet.Rows[i], true
+}
+
+// String returns the value of a string column, empty if it is missing.
+func (row Row) String(name string) string {
+	s, _ := row[name].(string)
+	return s
+}
+
+// Uint32 returns the value of an integer column, 0 if it is missing.
+func (row Row) Uint32(name string) uint32 {
+	switch v := row[name].(type) {
+	case uint32:
+		return v
+	case int32:
+		return uint32(v)
+	case uint64:
+		return uint32(v)
+	case int64:
+		return uint32(v)
+	default:
+		return 0
+	}
+}
+
+func decodeTableSchema(data []byte) (TableSchema, error) {
+	var table TableSchema
+	err := consumeFields(data, func(num protowire.Number, typ protowire.Type, _ uint64, b []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			table.Name = string(b)
+		case num == 2 && typ == protowire.BytesType:
+			sheet, err := decodeSheetSchema(b)
+			if err != nil {
+				return err
+			}
+			table.Sheets = append(table.Sheets, sheet)
+		}
+		return nil
+	})
+	return table, err
+}
+
+func decodeSheetSchema(data []byte) (SheetSchema, error) {
+	var sheet SheetSchema
+	err := consumeFields(data, func(num protowire.Number, typ protowire.Type, _ uint64, b []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			sheet.Name = string(b)
+		case num == 2 && typ == protowire.BytesType:
+			field, err := decodeFieldSchema(b)
+			if err != nil {
+				return err
+			}
+			sheet.Fields = append(sheet.Fields, field)
+		}
+		return nil
+	})
+	return sheet, err
+}
+
+func decodeFieldSchema(data []byte) (FieldSchema, error) {
+	var field FieldSchema
+	err := consumeFields(data, func(num protowire.Number, typ protowire.Type, v uint64, b []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			field.Name = string(b)
+		case num == 2 && typ == protowire.VarintType:
+			field.ArrayLength = uint32(v)
+		case num == 3 && typ == protowire.BytesType:
+			field.Type = string(b)
+		case num == 4 && typ == protowire.VarintType:
+			field.Index = uint32(v)
+		}
+		return nil
+	})
+	return field, err
+}
+
+// decodeTableData decodes the rows of a sheet, nil is returned for a sheet without schema.
+func decodeTableData(data []byte, schemas map[string]*SheetSchema) (*Sheet, error) {
+	sheet := &Sheet{
+		Table:  "",
+		Name:   "",
+		Schema: nil,
+		Rows:   nil,
+		ids:    nil,
+	}
+	var rows [][]byte
+	err := consumeFields(data, func(num protowire.Number, typ protowire.Type, _ uint64, b []byte) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			sheet.Table = string(b)
+		case num == 2 && typ == protowire.BytesType:
+			sheet.Name = string(b)
+		case num == 3 && typ == protowire.BytesType:
+			rows = append(rows, b)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sheet.Schema = schemas[sheetKey(sheet.Table, sheet.Name)]
+	if sheet.Schema == nil {
+		return nil, nil
+	}
+
+	fields := make(map[protowire.Number]*FieldSchema, len(sheet.Schema.Fields))
+	for i := range sheet.Schema.Fields {
+		field := &sheet.Schema.Fields[i]
+		fields[protowire.Number(field.Index)] = field
+		if field.Name == "id" {
+			sheet.ids = make(map[uint32]int, len(rows))
+		}
+	}
+	sheet.Rows = make([]Row, 0, len(rows))
+	for i, b := range rows {
+		row, err := decodeRow(b, fields)
+		if err != nil {
+			return nil, fmt.Errorf("%s row %d: %w", sheetKey(sheet.Table, sheet.Name), i, err)
+		}
+		if sheet.ids != nil {
+			sheet.ids[row.Uint32("id")] = len(sheet.Rows)
+		}
+		sheet.Rows = append(sheet.Rows, row)
+	}
+	return sheet, nil
+}
+
+func decodeRow(data []byte, fields map[protowire.Number]*FieldSchema) (Row, error) {
+	row := make(Row, len(fields))
+	err := consumeFields(data, func(num protowire.Number, typ protowire.Type, v uint64, b []byte) error {
+		field, ok := fields[num]
+		if !ok {
+			return nil
+		}
+		repeated := field.ArrayLength > 0
+		if repeated && typ == protowire.BytesType && packable(field.Type) {
+			values, err := decodePacked(field.Type, b)
+			if err != nil {
+				return fmt.Errorf("%s: %w", field.Name, err)
+			}
+			list, _ := row[field.Name].([]interface{})
+			row[field.Name] = append(list, values...)
+			return nil
+		}
+		value, err := decodeValue(field.Type, typ, v, b)
+		if err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+		if repeated {
+			list, _ := row[field.Name].([]interface{})
+			row[field.Name] = append(list, value)
+		} else {
+			row[field.Name] = value
+		}
+		return nil
+	})
+	return row, err
+}
+
+// wireType returns the wire type of a scalar pb_type, BytesType for strings, bytes and messages.
+func wireType(pbType string) protowire.Type {
+	switch pbType {
+	case "int32", "int64", "uint32", "uint64", "sint32", "sint64", "bool", "enum":
+		return protowire.VarintType
+	case "fixed32", "sfixed32", "float":
+		return protowire.Fixed32Type
+	case "fixed64", "sfixed64", "double":
+		return protowire.Fixed64Type
+	default:
+		return protowire.BytesType
+	}
+}
+
+func packable(pbType string) bool {
+	return wireType(pbType) != protowire.BytesType
+}
+
+func decodePacked(pbType string, data []byte) ([]interface{}, error) {
+	var values []interface{}
+	typ := wireType(pbType)
+	for len(data) > 0 {
+		var v uint64
+		var n int
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(data)
+		case protowire.Fixed32Type:
+			var v32 uint32
+			v32, n = protowire.ConsumeFixed32(data)
+			v = uint64(v32)
+		default:
+			v, n = protowire.ConsumeFixed64(data)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+		value, err := decodeValue(pbType, typ, v, nil)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// decodeValue converts a field of wire type typ to the Go value of pbType, messages are kept as bytes.
+func decodeValue(pbType string, typ protowire.Type, v uint64, b []byte) (interface{}, error) {
+	if want := wireType(pbType); want != typ {
+		return nil, fmt.Errorf("wire type %d does not match %s", typ, pbType)
+	}
+	switch pbType {
+	case "int32", "enum", "sfixed32":
+		return int32(v), nil
+	case "int64", "sfixed64":
+		return int64(v), nil
+	case "uint32", "fixed32":
+		return uint32(v), nil
+	case "uint64", "fixed64":
+		return v, nil
+	case "sint32":
+		return int32(protowire.DecodeZigZag(v & math.MaxUint32)), nil
+	case "sint64":
+		return protowire.DecodeZigZag(v), nil
+	case "bool":
+		return v != 0, nil
+	case "float":
+		return math.Float32frombits(uint32(v)), nil
+	case "double":
+		return math.Float64frombits(v), nil
+	case "string":
+		return string(b), nil
+	default:
+		return append([]byte(nil), b...), nil
+	}
+}
+
+// consumeFields calls fn with each field of the protobuf message in data,
+// v holds varint and fixed values, b the content of length-delimited ones.
+func consumeFields(data []byte, fn func(num protowire.Number, typ protowire.Type, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		var v uint64
+		var b []byte
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(data)
+		case protowire.Fixed32Type:
+			var v32 uint32
+			v32, n = protowire.ConsumeFixed32(data)
+			v = uint64(v32)
+		case protowire.Fixed64Type:
+			v, n = protowire.ConsumeFixed64(data)
+		case protowire.BytesType:
+			b, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		if err := fn(num, typ, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
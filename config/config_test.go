@@ -0,0 +1,195 @@
+package config
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// testField is a column given to testSchema.
+type testField struct {
+	name        string
+	arrayLength uint32
+	pbType      string
+	index       uint32
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+// testSchema encodes a TableSchema with a single sheet.
+func testSchema(table, sheet string, fields ...testField) []byte {
+	var sheetSchema []byte
+	sheetSchema = appendString(sheetSchema, 1, sheet)
+	for _, field := range fields {
+		var fieldSchema []byte
+		fieldSchema = appendString(fieldSchema, 1, field.name)
+		fieldSchema = appendVarint(fieldSchema, 2, uint64(field.arrayLength))
+		fieldSchema = appendString(fieldSchema, 3, field.pbType)
+		fieldSchema = appendVarint(fieldSchema, 4, uint64(field.index))
+		sheetSchema = appendBytes(sheetSchema, 2, fieldSchema)
+	}
+	var tableSchema []byte
+	tableSchema = appendString(tableSchema, 1, table)
+	return appendBytes(tableSchema, 2, sheetSchema)
+}
+
+// testData encodes a TableData.
+func testData(table, sheet string, rows ...[]byte) []byte {
+	var data []byte
+	data = appendString(data, 1, table)
+	data = appendString(data, 2, sheet)
+	for _, row := range rows {
+		data = appendBytes(data, 3, row)
+	}
+	return data
+}
+
+// testTables encodes a ConfigTables with a fan sheet, a character sheet with a packed repeated column,
+// an error sheet and the data of a sheet without schema.
+func testTables() []byte {
+	var b []byte
+	b = appendString(b, 1, "0.10.217.w")
+	b = appendString(b, 2, "hash")
+
+	b = appendBytes(b, 3, testSchema(FanTable, FanSheet,
+		testField{name: "id", pbType: "uint32", index: 1},
+		testField{name: "name_chs", pbType: "string", index: 2},
+		testField{name: "name_en", pbType: "string", index: 3},
+		testField{name: "fan_menqing", pbType: "int32", index: 4},
+	))
+	b = appendBytes(b, 3, testSchema(ItemTable, CharacterSheet,
+		testField{name: "id", pbType: "uint32", index: 1},
+		testField{name: "name_chs", pbType: "string", index: 2},
+		testField{name: "name_jp", pbType: "string", index: 3},
+		testField{name: "emo", arrayLength: 3, pbType: "uint32", index: 4},
+	))
+	b = appendBytes(b, 3, testSchema(InfoTable, ErrorSheet,
+		testField{name: "id", pbType: "uint32", index: 1},
+		testField{name: "chs", pbType: "string", index: 2},
+		testField{name: "en", pbType: "string", index: 3},
+	))
+
+	var riichi []byte
+	riichi = appendVarint(riichi, 1, 2)
+	riichi = appendString(riichi, 2, "立直")
+	riichi = appendString(riichi, 3, "Riichi")
+	riichi = appendVarint(riichi, 4, 1)
+	var tsumo []byte
+	tsumo = appendVarint(tsumo, 1, 1)
+	tsumo = appendString(tsumo, 2, "门前清自摸和")
+	b = appendBytes(b, 4, testData(FanTable, FanSheet, riichi, tsumo))
+
+	var packed []byte
+	for _, v := range []uint64{10, 20, 300} {
+		packed = protowire.AppendVarint(packed, v)
+	}
+	var character []byte
+	character = appendVarint(character, 1, 200001)
+	character = appendString(character, 2, "一姬")
+	character = appendString(character, 3, "一姫")
+	character = appendBytes(character, 4, packed)
+	b = appendBytes(b, 4, testData(ItemTable, CharacterSheet, character))
+
+	var accountNotFound []byte
+	accountNotFound = appendVarint(accountNotFound, 1, 1002)
+	accountNotFound = appendString(accountNotFound, 2, "账号不存在")
+	accountNotFound = appendString(accountNotFound, 3, "Account not found")
+	var empty []byte
+	empty = appendVarint(empty, 1, 1)
+	b = appendBytes(b, 4, testData(InfoTable, ErrorSheet, accountNotFound, empty))
+
+	b = appendBytes(b, 4, testData("unknown", "sheet", appendVarint(nil, 1, 1)))
+	return b
+}
+
+func TestDecode(t *testing.T) {
+	tables, err := Decode(testTables())
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if tables.Version != "0.10.217.w" || tables.HeaderHash != "hash" {
+		t.Errorf("Version, HeaderHash = %q, %q", tables.Version, tables.HeaderHash)
+	}
+	if len(tables.Schemas) != 3 {
+		t.Fatalf("len(Schemas) = %d, want 3", len(tables.Schemas))
+	}
+	if _, ok := tables.Sheet("unknown", "sheet"); ok {
+		t.Error("sheet without schema was decoded")
+	}
+
+	sheet, ok := tables.Sheet(ItemTable, CharacterSheet)
+	if !ok {
+		t.Fatal("character sheet not found")
+	}
+	row, ok := sheet.Find(200001)
+	if !ok {
+		t.Fatal("character 200001 not found")
+	}
+	if emo := row["emo"]; !reflect.DeepEqual(emo, []interface{}{uint32(10), uint32(20), uint32(300)}) {
+		t.Errorf("emo = %#v, want the packed values", emo)
+	}
+	if _, ok = sheet.Find(1); ok {
+		t.Error("Find returned a missing id")
+	}
+
+	fans, _ := tables.Sheet(FanTable, FanSheet)
+	if row, _ = fans.Find(2); row["fan_menqing"] != int32(1) {
+		t.Errorf("fan_menqing = %#v, want int32(1)", row["fan_menqing"])
+	}
+}
+
+func TestLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lqc.lqbin")
+	if err := os.WriteFile(path, testTables(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tables, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	fan, ok := tables.Fan(2)
+	if !ok {
+		t.Fatal("fan 2 not found")
+	}
+	if fan.In(LangEN) != "Riichi" || fan.In(LangCHS) != "立直" {
+		t.Errorf("fan 2 = %v", fan.Names)
+	}
+	if fan, _ = tables.Fan(1); fan.In(LangEN) != "门前清自摸和" {
+		t.Errorf("fan 1 in English = %q, want the Chinese fallback", fan.In(LangEN))
+	}
+	if _, ok = tables.Fan(3); ok {
+		t.Error("Fan returned a missing id")
+	}
+
+	character, ok := tables.Character(200001)
+	if !ok {
+		t.Fatal("character 200001 not found")
+	}
+	if character.In(LangJP) != "一姫" || character.String() != "一姬" {
+		t.Errorf("character 200001 = %v", character.Names)
+	}
+
+	want := map[uint32]string{1002: "Account not found"}
+	if messages := tables.ErrorMessages(LangEN); !reflect.DeepEqual(messages, want) {
+		t.Errorf("ErrorMessages(LangEN) = %v, want %v", messages, want)
+	}
+	if messages := tables.ErrorMessages(LangKR); messages[1002] != "账号不存在" {
+		t.Errorf("ErrorMessages(LangKR)[1002] = %q, want the Chinese fallback", messages[1002])
+	}
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Lang is the suffix of the localized columns of the config tables.
+type Lang string
+
+const (
+	LangCHS Lang = "chs"   // Simplified Chinese
+	LangCHT Lang = "chs_t" // Traditional Chinese
+	LangJP  Lang = "jp"    // Japanese
+	LangEN  Lang = "en"    // English
+	LangKR  Lang = "kr"    // Korean
+)
+
+// Langs lists the languages of the config tables.
+var Langs = []Lang{LangCHS, LangCHT, LangJP, LangEN, LangKR}
+
+// Sheets of the config tables behind the lookups.
+const (
+	FanTable       = "fan"             // Sheet "fan": yaku and fan, the ids of FanInfo
+	ItemTable      = "item_definition" // Sheets "character", "item", "title" and "skin"
+	InfoTable      = "info"            // Sheet "error": server error messages
+	FanSheet       = "fan"
+	CharacterSheet = "character"
+	ItemSheet      = "item"
+	TitleSheet     = "title"
+	SkinSheet      = "skin"
+	ErrorSheet     = "error"
+)
+
+// Name is a row of the config tables with its localized names.
+type Name struct {
+	ID    uint32
+	Names map[Lang]string
+}
+
+// In returns the name in lang, falling back to Simplified Chinese which every row has.
+func (name Name) In(lang Lang) string {
+	if s, ok := name.Names[lang]; ok && len(s) != 0 {
+		return s
+	}
+	return name.Names[LangCHS]
+}
+
+func (name Name) String() string {
+	if s := name.In(LangCHS); len(s) != 0 {
+		return s
+	}
+	return fmt.Sprintf("#%d", name.ID)
+}
+
+// nameOf reads the localized names of row, in the columns name_<lang>, or <lang> for sheets like "error".
+func nameOf(row Row) Name {
+	name := Name{
+		ID:    row.Uint32("id"),
+		Names: make(map[Lang]string, len(Langs)),
+	}
+	for _, lang := range Langs {
+		s := row.String(fmt.Sprintf("name_%s", lang))
+		if len(s) == 0 {
+			s = row.String(string(lang))
+		}
+		if len(s) != 0 {
+			name.Names[lang] = s
+		}
+	}
+	return name
+}
+
+// Name returns the localized name of the row id of a sheet.
+func (tables *Tables) Name(table, sheet string, id uint32) (Name, bool) {
+	s, ok := tables.Sheet(table, sheet)
+	if !ok {
+		return Name{}, false
+	}
+	row, ok := s.Find(id)
+	if !ok {
+		return Name{}, false
+	}
+	return nameOf(row), true
+}
+
+// Names returns the localized names of every row of a sheet, ordered by id.
+func (tables *Tables) Names(table, sheet string) []Name {
+	s, ok := tables.Sheet(table, sheet)
+	if !ok {
+		return nil
+	}
+	names := make([]Name, 0, len(s.Rows))
+	for _, row := range s.Rows {
+		names = append(names, nameOf(row))
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].ID < names[j].ID
+	})
+	return names
+}
+
+// Fan returns the yaku or fan of a FanInfo id.
+func (tables *Tables) Fan(id uint32) (Name, bool) {
+	return tables.Name(FanTable, FanSheet, id)
+}
+
+// Character returns the character of a charid.
+func (tables *Tables) Character(id uint32) (Name, bool) {
+	return tables.Name(ItemTable, CharacterSheet, id)
+}
+
+// Item returns the item of an item id, like the ones of FetchBagInfo.
+func (tables *Tables) Item(id uint32) (Name, bool) {
+	return tables.Name(ItemTable, ItemSheet, id)
+}
+
+// Title returns the title of a title_id.
+func (tables *Tables) Title(id uint32) (Name, bool) {
+	return tables.Name(ItemTable, TitleSheet, id)
+}
+
+// Skin returns the skin of an avatar_id.
+func (tables *Tables) Skin(id uint32) (Name, bool) {
+	return tables.Name(ItemTable, SkinSheet, id)
+}
+
+// ErrorMessages returns the message of every server error code in lang,
+// it can be given to majsoul.RegisterErrorMessages.
+func (tables *Tables) ErrorMessages(lang Lang) map[uint32]string {
+	names := tables.Names(InfoTable, ErrorSheet)
+	messages := make(map[uint32]string, len(names))
+	for _, name := range names {
+		if s := name.In(lang); len(s) != 0 {
+			messages[name.ID] = s
+		}
+	}
+	return messages
+}
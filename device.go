@@ -0,0 +1,54 @@
+package majsoul
+
+import (
+	"github.com/constellation39/majsoul/message"
+	"math/rand"
+)
+
+// DeviceProfile describes the device reported to the Majsoul server on login.
+type DeviceProfile struct {
+	Platform       string `json:"platform"`
+	Hardware       string `json:"hardware"`
+	Os             string `json:"os"`
+	OsVersion      string `json:"osVersion"`
+	IsBrowser      bool   `json:"isBrowser"`
+	Software       string `json:"software"`
+	SalePlatform   string `json:"salePlatform"`
+	HardwareVendor string `json:"hardwareVendor"`
+	ModelNumber    string `json:"modelNumber"`
+	ScreenWidth    uint32 `json:"screenWidth"`
+	ScreenHeight   uint32 `json:"screenHeight"`
+}
+
+// NewDeviceProfile returns the profile of a Chrome browser on Windows with a random screen size.
+func NewDeviceProfile() *DeviceProfile {
+	return &DeviceProfile{
+		Platform:       "pc",
+		Hardware:       "pc",
+		Os:             "windows",
+		OsVersion:      "win10",
+		IsBrowser:      true,
+		Software:       "Chrome",
+		SalePlatform:   "web",
+		HardwareVendor: "",
+		ModelNumber:    "",
+		ScreenWidth:    uint32(rand.Int31n(400) + 914),
+		ScreenHeight:   uint32(rand.Int31n(200) + 1316),
+	}
+}
+
+func (profile *DeviceProfile) info() *message.ClientDeviceInfo {
+	return &message.ClientDeviceInfo{
+		Platform:       profile.Platform,
+		Hardware:       profile.Hardware,
+		Os:             profile.Os,
+		OsVersion:      profile.OsVersion,
+		IsBrowser:      profile.IsBrowser,
+		Software:       profile.Software,
+		SalePlatform:   profile.SalePlatform,
+		HardwareVendor: profile.HardwareVendor,
+		ModelNumber:    profile.ModelNumber,
+		ScreenWidth:    profile.ScreenWidth,
+		ScreenHeight:   profile.ScreenHeight,
+	}
+}
@@ -0,0 +1,150 @@
+package majsoul
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sync"
+)
+
+// ErrorCode is the code of a server error. The common codes below are sentinels to compare a *ServerError with,
+// errors.Is(err, ErrWrongPassword) reports whether err is a *ServerError with that code.
+type ErrorCode uint32
+
+// Common server errors.
+// Their codes are ids of the error sheet of the client info table in lqc.lqbin,
+// config.InfoTable and config.ErrorSheet, the sheet read by config.Tables.ErrorMessages.
+const (
+	ErrAccountNotFound ErrorCode = 1002 // 账号不存在
+	ErrWrongPassword   ErrorCode = 1003 // 密码错误
+	ErrRoomNotFound    ErrorCode = 1101 // 房间不存在
+	ErrRoomFull        ErrorCode = 1105 // 房间已满
+	ErrNotInRoom       ErrorCode = 1109 // 不在房间中
+	ErrNotInGame       ErrorCode = 1501 // 不在游戏中
+)
+
+// errorMessages holds the message of each known error code. The bundled table is partial, it only covers
+// the common errors above. The messages of every other code come from config.Tables.ErrorMessages
+// given to RegisterErrorMessages, without them an error reads "server error <code>".
+var (
+	errorMessagesMutex sync.RWMutex
+	errorMessages      = map[ErrorCode]string{
+		ErrAccountNotFound: "account not found",
+		ErrWrongPassword:   "wrong password",
+		ErrRoomNotFound:    "room not found",
+		ErrRoomFull:        "room is full",
+		ErrNotInRoom:       "not in room",
+		ErrNotInGame:       "not in game",
+	}
+)
+
+// RegisterErrorMessages adds or replaces the human-readable messages of server error codes,
+// typically with the localized table of the game client returned by config.Tables.ErrorMessages.
+func RegisterErrorMessages(messages map[uint32]string) {
+	errorMessagesMutex.Lock()
+	defer errorMessagesMutex.Unlock()
+	for code, msg := range messages {
+		errorMessages[ErrorCode(code)] = msg
+	}
+}
+
+// Message returns the human-readable message of the code, empty if the code is unknown.
+func (code ErrorCode) Message() string {
+	errorMessagesMutex.RLock()
+	defer errorMessagesMutex.RUnlock()
+	return errorMessages[code]
+}
+
+func (code ErrorCode) Error() string {
+	if msg := code.Message(); len(msg) != 0 {
+		return fmt.Sprintf("majsoul: server error %d: %s", uint32(code), msg)
+	}
+	return fmt.Sprintf("majsoul: server error %d", uint32(code))
+}
+
+// ServerError is the message.Error carried by a response whose code is not zero.
+type ServerError struct {
+	Code      uint32
+	U32Params []uint32
+	StrParams []string
+	JsonParam string
+}
+
+// Message returns the human-readable message of the error code, empty if the code is unknown.
+func (e *ServerError) Message() string {
+	return ErrorCode(e.Code).Message()
+}
+
+func (e *ServerError) Error() string {
+	return ErrorCode(e.Code).Error()
+}
+
+// Is reports whether target is an ErrorCode, or a *ServerError, with the same code.
+func (e *ServerError) Is(target error) bool {
+	switch t := target.(type) {
+	case ErrorCode:
+		return uint32(t) == e.Code
+	case *ServerError:
+		return t.Code == e.Code
+	default:
+		return false
+	}
+}
+
+// CheckError returns a *ServerError if response carries a message.Error with a non-zero code, nil otherwise.
+// The fields are read through reflection, so that dynamic responses of InvokeDynamic are checked too.
+func CheckError(response proto.Message) error {
+	if response == nil {
+		return nil
+	}
+	m := response.ProtoReflect()
+	field := m.Descriptor().Fields().ByName("error")
+	if field == nil || field.Kind() != protoreflect.MessageKind || !m.Has(field) {
+		return nil
+	}
+	e := m.Get(field).Message()
+	fields := e.Descriptor().Fields()
+	code := fields.ByName("code")
+	if code == nil || code.Kind() != protoreflect.Uint32Kind || e.Get(code).Uint() == 0 {
+		return nil
+	}
+	serverError := &ServerError{
+		Code:      uint32(e.Get(code).Uint()),
+		U32Params: nil,
+		StrParams: nil,
+		JsonParam: "",
+	}
+	if f := fields.ByName("u32_params"); f != nil && f.IsList() && f.Kind() == protoreflect.Uint32Kind {
+		list := e.Get(f).List()
+		for i := 0; i < list.Len(); i++ {
+			serverError.U32Params = append(serverError.U32Params, uint32(list.Get(i).Uint()))
+		}
+	}
+	if f := fields.ByName("str_params"); f != nil && f.IsList() && f.Kind() == protoreflect.StringKind {
+		list := e.Get(f).List()
+		for i := 0; i < list.Len(); i++ {
+			serverError.StrParams = append(serverError.StrParams, list.Get(i).String())
+		}
+	}
+	if f := fields.ByName("json_param"); f != nil && !f.IsList() && f.Kind() == protoreflect.StringKind {
+		serverError.JsonParam = e.Get(f).String()
+	}
+	return serverError
+}
+
+// ServerErrorInterceptor returns an interceptor that turns a response carrying a non-zero message.Error
+// into a *ServerError. Add it to Config.UnaryInterceptors to opt in.
+func ServerErrorInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			return err
+		}
+		if response, ok := reply.(proto.Message); ok {
+			return CheckError(response)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+package majsoul
+
+import (
+	"errors"
+	"github.com/constellation39/majsoul/message"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"reflect"
+	"testing"
+)
+
+func TestCheckError(t *testing.T) {
+	want := &ServerError{
+		Code:      uint32(ErrRoomFull),
+		U32Params: []uint32{1, 2},
+		StrParams: []string{"room"},
+		JsonParam: `{"room":1}`,
+	}
+	if err := CheckError(&message.ResCommon{}); err != nil {
+		t.Errorf("CheckError without error = %v, want nil", err)
+	}
+	err := CheckError(&message.ResCommon{Error: &message.Error{
+		Code:      want.Code,
+		U32Params: want.U32Params,
+		StrParams: want.StrParams,
+		JsonParam: want.JsonParam,
+	}})
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("CheckError = %#v, want %#v", err, want)
+	}
+	if !errors.Is(err, ErrRoomFull) || errors.Is(err, ErrNotInRoom) || !errors.Is(err, &ServerError{Code: want.Code}) {
+		t.Errorf("errors.Is does not match %v on its code", err)
+	}
+	if msg := err.Error(); msg != "majsoul: server error 1105: room is full" {
+		t.Errorf("Error() = %q", msg)
+	}
+
+	registry, err := LoadRegistry("proto/liqi.json")
+	if err != nil {
+		t.Fatalf("LoadRegistry: %v", err)
+	}
+	res, err := registry.NewMessage("lq.ResCommon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = CheckError(res); err != nil {
+		t.Errorf("CheckError of an empty dynamic response = %v, want nil", err)
+	}
+	e := res.Mutable(res.Descriptor().Fields().ByName("error")).Message()
+	fields := e.Descriptor().Fields()
+	e.Set(fields.ByName("code"), protoreflect.ValueOfUint32(want.Code))
+	for _, v := range want.U32Params {
+		e.Mutable(fields.ByName("u32_params")).List().Append(protoreflect.ValueOfUint32(v))
+	}
+	for _, v := range want.StrParams {
+		e.Mutable(fields.ByName("str_params")).List().Append(protoreflect.ValueOfString(v))
+	}
+	e.Set(fields.ByName("json_param"), protoreflect.ValueOfString(want.JsonParam))
+	if err = CheckError(res); !reflect.DeepEqual(err, want) {
+		t.Errorf("CheckError of a dynamic response = %#v, want %#v", err, want)
+	}
+}
+
+func TestRegisterErrorMessages(t *testing.T) {
+	const code ErrorCode = 65535
+	if msg := code.Error(); msg != "majsoul: server error 65535" {
+		t.Errorf("Error() of an unknown code = %q", msg)
+	}
+	RegisterErrorMessages(map[uint32]string{uint32(code): "test"})
+	t.Cleanup(func() {
+		errorMessagesMutex.Lock()
+		delete(errorMessages, code)
+		errorMessagesMutex.Unlock()
+	})
+	if msg := (&ServerError{Code: uint32(code)}).Error(); msg != "majsoul: server error 65535: test" {
+		t.Errorf("Error() of a registered code = %q", msg)
+	}
+}
@@ -0,0 +1,212 @@
+package majsoul
+
+import (
+	"context"
+	"fmt"
+	"github.com/constellation39/majsoul/logger"
+	"github.com/constellation39/majsoul/message"
+	"github.com/constellation39/majsoul/network"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// Source identifies the connection a message was received from.
+type Source int
+
+const (
+	SourceLobby Source = iota + 1 // Lobby connection, used by LobbyClient
+	SourceGame                    // Game connection, used by FastTestClient
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceLobby:
+		return "lobby"
+	case SourceGame:
+		return "game"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a decoded notify or action received from the server.
+type Event struct {
+	Source  Source           // Connection the message was received from
+	Name    string           // Full name of the message, e.g. "lq.ActionDiscardTile"
+	Message proto.Message    // Decoded message, shared between subscribers and must not be modified
+	Time    time.Time        // Time the message was received
+	Step    uint32           // Step of the ActionPrototype carrying an action, 0 for notifies
+	Wrapper *message.Wrapper // Raw wrapper received from the server, for actions the wrapper of the ActionPrototype
+}
+
+// JSON returns the message of the event encoded as protobuf JSON, for messages of the message package
+// as well as *dynamicpb.Message decoded with a Registry.
+func (event *Event) JSON() ([]byte, error) {
+	if event.Message == nil {
+		return nil, fmt.Errorf("majsoul: event %s has no message", event.Name)
+	}
+	return protojson.Marshal(event.Message)
+}
+
+// EventFilter reports whether an event should be delivered to a stream.
+type EventFilter func(event *Event) bool
+
+// FilterName accepts events whose full message name is one of names.
+func FilterName(names ...string) EventFilter {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[string(fullName(name))] = struct{}{}
+	}
+	return func(event *Event) bool {
+		_, ok := set[event.Name]
+		return ok
+	}
+}
+
+// FilterSource accepts events received from source.
+func FilterSource(source Source) EventFilter {
+	return func(event *Event) bool {
+		return event.Source == source
+	}
+}
+
+// eventStream is a channel returned by Events.
+type eventStream struct {
+	ctx      context.Context
+	closing  <-chan struct{} // closed when MajSoul.Close is called
+	filters  []EventFilter
+	overflow network.OverflowPolicy
+	mutex    sync.Mutex // Guards events and closed
+	events   chan Event
+	closed   bool
+}
+
+func (stream *eventStream) accept(event *Event) bool {
+	for _, filter := range stream.filters {
+		if !filter(event) {
+			return false
+		}
+	}
+	return true
+}
+
+func (stream *eventStream) send(event Event) {
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+	if stream.closed {
+		return
+	}
+	switch stream.overflow {
+	case network.OverflowDropNewest:
+		select {
+		case stream.events <- event:
+		default:
+			logger.Warn("event stream is full, drop newest event", zap.String("name", event.Name))
+		}
+	case network.OverflowDropOldest:
+		for {
+			select {
+			case stream.events <- event:
+				return
+			default:
+			}
+			select {
+			case dropped := <-stream.events:
+				logger.Warn("event stream is full, drop oldest event", zap.String("name", dropped.Name))
+			default:
+			}
+		}
+	default:
+		select {
+		case stream.events <- event:
+		case <-stream.ctx.Done():
+		case <-stream.closing:
+		}
+	}
+}
+
+func (stream *eventStream) close() {
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+	if stream.closed {
+		return
+	}
+	stream.closed = true
+	close(stream.events)
+}
+
+// Events returns a channel of every notify and action accepted by all filters.
+// The channel is buffered by Config.EventBuffer, a full channel is handled by Config.EventOverflow.
+// It is closed once ctx is done or MajSoul.Close is called.
+func (majSoul *MajSoul) Events(ctx context.Context, filters ...EventFilter) <-chan Event {
+	size := majSoul.config.EventBuffer
+	if size <= 0 {
+		size = defaultEventBuffer
+	}
+	stream := &eventStream{
+		ctx:      ctx,
+		closing:  majSoul.lifecycle.closing,
+		filters:  filters,
+		overflow: majSoul.config.EventOverflow,
+		events:   make(chan Event, size),
+	}
+
+	majSoul.handleMutex.Lock()
+	if majSoul.isClosing() {
+		majSoul.handleMutex.Unlock()
+		stream.close()
+		return stream.events
+	}
+	majSoul.streams = append(majSoul.streams[:len(majSoul.streams):len(majSoul.streams)], stream)
+	majSoul.handleMutex.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-majSoul.lifecycle.done:
+			return
+		}
+		majSoul.handleMutex.Lock()
+		for i, s := range majSoul.streams {
+			if s == stream {
+				remain := make([]*eventStream, 0, len(majSoul.streams)-1)
+				remain = append(remain, majSoul.streams[:i]...)
+				majSoul.streams = append(remain, majSoul.streams[i+1:]...)
+				break
+			}
+		}
+		majSoul.handleMutex.Unlock()
+		stream.close()
+	}()
+
+	return stream.events
+}
+
+// eventStreams returns the open streams. The returned slice must not be modified.
+func (majSoul *MajSoul) eventStreams() []*eventStream {
+	majSoul.handleMutex.RLock()
+	defer majSoul.handleMutex.RUnlock()
+	return majSoul.streams
+}
+
+// closeEventStreams closes every stream opened by Events, it is called by Close.
+func (majSoul *MajSoul) closeEventStreams() {
+	majSoul.handleMutex.Lock()
+	streams := majSoul.streams
+	majSoul.streams = nil
+	majSoul.handleMutex.Unlock()
+	for _, stream := range streams {
+		stream.close()
+	}
+}
+
+func (majSoul *MajSoul) publish(event *Event) {
+	for _, stream := range majSoul.eventStreams() {
+		if stream.accept(event) {
+			stream.send(*event)
+		}
+	}
+}
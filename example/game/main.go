@@ -2,89 +2,24 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"github.com/constellation39/majsoul"
 	"github.com/constellation39/majsoul/logger"
 	"github.com/constellation39/majsoul/message"
 	"go.uber.org/zap"
-	"math/rand"
 	"os"
+	"os/signal"
 	"time"
 )
 
-func ReConnGame(gameState *GameState, majSoul *majsoul.MajSoul, resLogin *message.ResLogin) {
-	if resLogin.Account == nil || resLogin.Account.RoomId == 0 {
-		return
-	}
-	{ // 尝试重连到游戏中
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-		defer cancel()
-		if err := majSoul.ConnGame(ctx); err != nil {
-			logger.Panic("client ConnGame error.", zap.Error(err))
-		}
-	}
-
-	{ // 尝试验证身份
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-		defer cancel()
-		var err error
-		gameState.gameInfo, err = majSoul.FastTestClient.AuthGame(ctx, &message.ReqAuthGame{
-			AccountId: resLogin.Account.AccountId,
-			Token:     resLogin.GameInfo.ConnectToken,
-			GameUuid:  resLogin.GameInfo.GameUuid,
-		})
-		if err != nil {
-			logger.Panic("client AuthGame error.", zap.Error(err))
-		}
-	}
-
-	// 记录自己的座位号
-	for i, uid := range gameState.gameInfo.SeatList {
-		if uid == resLogin.Account.AccountId {
+// updateSeat 记录自己的座位号
+func updateSeat(gameState *GameState, resAuthGame *message.ResAuthGame) {
+	gameState.gameInfo = resAuthGame
+	for i, uid := range resAuthGame.SeatList {
+		if uid == gameState.account.AccountId {
 			gameState.seat = uint32(i)
 			break
 		}
 	}
-
-	{ // 尝试同步游戏数据
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-		defer cancel()
-		if resSyncGame, err := majSoul.FastTestClient.SyncGame(ctx, &message.ReqSyncGame{RoundId: "-1"}); err != nil {
-			logger.Panic("majSoul SyncGame error.", zap.Error(err))
-		} else {
-			logger.Debug("majSoul SyncGame.", zap.Reflect("resSyncGame", resSyncGame))
-		}
-	}
-
-	{ // 获取当前玩家状态
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-		defer cancel()
-		if _, err := majSoul.FastTestClient.FetchGamePlayerState(ctx, &message.ReqCommon{}); err != nil {
-			logger.Panic("majSoul FetchGamePlayerState error.", zap.Error(err))
-		} else {
-			logger.Debug("majSoul FetchGamePlayerState.")
-		}
-	}
-
-	{ // 告诉服务器状态同步已经完成
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-		defer cancel()
-		if _, err := majSoul.FastTestClient.FinishSyncGame(ctx, &message.ReqCommon{}); err != nil {
-			logger.Panic("majSoul FinishSyncGame error.", zap.Error(err))
-		} else {
-			logger.Debug("majSoul FinishSyncGame.")
-		}
-	}
-
-	{ // 获取当前玩家状态
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-		defer cancel()
-		if _, err := majSoul.FastTestClient.FetchGamePlayerState(ctx, &message.ReqCommon{}); err != nil {
-			logger.Panic("majSoul FetchGamePlayerState error.", zap.Error(err))
-		} else {
-			logger.Debug("majSoul FetchGamePlayerState.")
-		}
-	}
 }
 
 func UpdateLoginInfo(majSoul *majsoul.MajSoul) {
@@ -318,149 +253,60 @@ func main() {
 		UpdateLoginInfo(majSoul)
 
 		// 尝试重连到游戏中
-		ReConnGame(gameState, majSoul, resLogin)
-	}
-
-	// 添加网关重连时进行的操作
-	majSoul.OnGatewayReconnect(func() {
-		if len(gameState.accessToken) == 0 {
-			panic(fmt.Sprintf(""))
-		}
-		var err error
-		{ // 检查 token 是否可用
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		if resLogin.GameInfo != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
 			defer cancel()
-			_, err = majSoul.LobbyClient.Oauth2Check(ctx, &message.ReqOauth2Check{AccessToken: gameState.accessToken})
+			resAuthGame, _, err := majSoul.RestoreGame(ctx)
 			if err != nil {
-				panic(fmt.Sprintf("gateway Oauth2Check error %v", err))
+				logger.Panic("majSoul RestoreGame error.", zap.Error(err))
 			}
+			updateSeat(gameState, resAuthGame)
 		}
-		{ //
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-			defer cancel()
-			resLogin, err := majSoul.LobbyClient.Oauth2Login(ctx, &message.ReqOauth2Login{
-				AccessToken: gameState.accessToken,
-				Device: &message.ClientDeviceInfo{
-					Platform:       "pc",
-					Hardware:       "pc",
-					Os:             "windows",
-					OsVersion:      "win10",
-					IsBrowser:      true,
-					Software:       "Chrome",
-					SalePlatform:   "web",
-					HardwareVendor: "",
-					ModelNumber:    "",
-					ScreenWidth:    uint32(rand.Int31n(400) + 914),
-					ScreenHeight:   uint32(rand.Int31n(200) + 1316),
-				},
-				Reconnect: false,
-				RandomKey: majSoul.UUID,
-				ClientVersion: &message.ClientVersionInfo{
-					Resource: majSoul.Version.Version,
-					Package:  "",
-				},
-				GenAccessToken:      false,
-				CurrencyPlatforms:   []uint32{2, 6, 8, 10, 11},
-				ClientVersionString: majSoul.Version.Web(),
-			})
+	}
 
-			if err != nil {
-				panic(fmt.Sprintf("gateway Oauth2Login error %v", err))
-			}
-			if resLogin.Error != nil {
-				panic(resLogin.Error)
-			}
-			gameState.account = resLogin.Account
-			gameState.accessToken = resLogin.AccessToken
-			if resLogin.GameInfo != nil {
-				gameState.connectToken = resLogin.GameInfo.ConnectToken
-				gameState.gameUuid = resLogin.GameInfo.GameUuid
-			}
-			UpdateLoginInfo(majSoul)
-			ReConnGame(gameState, majSoul, resLogin)
+	// 网关重连后会自动使用 access token 重新登录
+	majSoul.OnLobbyRestored(func(resLogin *message.ResLogin, err error) {
+		if err != nil {
+			logger.Error("majSoul restore login error.", zap.Error(err))
+			return
 		}
+		gameState.account = resLogin.Account
+		UpdateLoginInfo(majSoul)
 	})
 
-	// 添加游戏服务器重连时进行的操作
-	majSoul.OnGameReconnect(func() {
-		{ // 验证用户身份
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-			defer cancel()
-			var err error
-			_, err = majSoul.FastTestClient.AuthGame(ctx, &message.ReqAuthGame{
-				AccountId: gameState.account.AccountId,
-				Token:     gameState.connectToken,
-				GameUuid:  gameState.gameUuid,
-			})
-			if err != nil {
-				logger.Error("majSoul AuthGame error.", zap.Error(err))
-				return
-			}
-		}
-
-		{ // 同步游戏状态
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-			defer cancel()
-			resSyncGame, err := majSoul.FastTestClient.SyncGame(ctx, &message.ReqSyncGame{RoundId: "-1"})
-			if err != nil {
-				logger.Error("majSoul SyncGame error.", zap.Error(err))
-				return
-			} else {
-				logger.Debug("majSoul SyncGame.", zap.Reflect("resSyncGame", resSyncGame))
-			}
-		}
-
-		{ // 同步玩家状态
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-			defer cancel()
-			if _, err := majSoul.FastTestClient.FetchGamePlayerState(ctx, &message.ReqCommon{}); err != nil {
-				logger.Error("majSoul FetchGamePlayerState error.", zap.Error(err))
-				return
-			} else {
-				logger.Debug("majSoul FetchGamePlayerState.")
-			}
-		}
-
-		{ // 完成游戏数据同步
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-			defer cancel()
-			if _, err := majSoul.FastTestClient.FinishSyncGame(ctx, &message.ReqCommon{}); err != nil {
-				logger.Error("majSoul FinishSyncGame error.", zap.Error(err))
-				return
-			} else {
-				logger.Debug("majSoul FinishSyncGame.")
-			}
-		}
-
-		{ // 同步玩家状态
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-			defer cancel()
-			if _, err := majSoul.FastTestClient.FetchGamePlayerState(ctx, &message.ReqCommon{}); err != nil {
-				logger.Error("majSoul FetchGamePlayerState error.", zap.Error(err))
-				return
-			} else {
-				logger.Debug("majSoul FetchGamePlayerState.")
-			}
+	// 游戏服务器重连后会自动同步游戏状态
+	majSoul.OnGameRestored(func(resAuthGame *message.ResAuthGame, gameRestore *message.GameRestore, err error) {
+		if err != nil {
+			logger.Error("majSoul restore game error.", zap.Error(err))
+			return
 		}
+		updateSeat(gameState, resAuthGame)
+		logger.Debug("majSoul game restored.", zap.Reflect("gameRestore", gameRestore))
 	})
 
 	// 响应以下消息
-	majSoul.Handle(
-		gameState.NotifyClientMessage,
-		gameState.NotifyFriendViewChange,
-		gameState.NotifyEndGameVote,
-		gameState.NotifyRoomGameStart,
-		//gameState.ActionMJStart,
-		//gameState.ActionNewRound,
-		//gameState.ActionDealTile,
-		//gameState.ActionDiscardTile,
-		//gameState.ActionChiPengGang,
-		//gameState.ActionAnGangAddGang,
-		//gameState.ActionHule,
-		//gameState.ActionLiuJu,
-		//gameState.ActionNoTile,
-	)
+	majsoul.On(majSoul, gameState.NotifyClientMessage)
+	majsoul.On(majSoul, gameState.NotifyFriendViewChange)
+	majsoul.On(majSoul, gameState.NotifyEndGameVote)
+	majsoul.On(majSoul, gameState.NotifyRoomGameStart)
+	//majsoul.On(majSoul, gameState.ActionMJStart)
+	//majsoul.On(majSoul, gameState.ActionNewRound)
+	//majsoul.On(majSoul, gameState.ActionDealTile)
+	//majsoul.On(majSoul, gameState.ActionDiscardTile)
+	//majsoul.On(majSoul, gameState.ActionChiPengGang)
+	//majsoul.On(majSoul, gameState.ActionAnGangAddGang)
+	//majsoul.On(majSoul, gameState.ActionHule)
+	//majsoul.On(majSoul, gameState.ActionLiuJu)
+	//majsoul.On(majSoul, gameState.ActionNoTile)
 
 	logger.Debug("Game Startup")
-	select {}
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	<-interrupt
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	if err := majSoul.Close(ctx); err != nil {
+		logger.Error("majSoul close error.", zap.Error(err))
+	}
 }
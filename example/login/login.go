@@ -21,25 +21,14 @@ func main() {
 	}
 
 	majSoul := majsoul.NewMajSoul(&majsoul.Config{ProxyAddress: ""})
-	{ // 寻找可用服务器
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	{ // 登录, 优先使用保存的 access token, 失败时才使用密码
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 		defer cancel()
-		err := majSoul.LookupGateway(ctx, majsoul.ServerAddressList)
+		resLogin, err := majSoul.Resume(ctx, majsoul.NewFileSessionStore("session.json"), account, password)
 		if err != nil {
 			panic(err)
 		}
-	}
-
-	{ // 登录
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-		defer cancel()
-		resLogin, err := majSoul.Login(ctx, account, password)
-		if err != nil {
-			panic(err)
-		}
-		if resLogin.Error != nil {
-			panic(resLogin.Error)
-		}
+		fmt.Printf("%v", resLogin.Account)
 	}
 
 	{ // 获取好友列表
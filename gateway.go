@@ -0,0 +1,262 @@
+package majsoul
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/constellation39/majsoul/logger"
+	"github.com/constellation39/majsoul/network"
+	"github.com/constellation39/majsoul/resources"
+	"go.uber.org/zap"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	gatewayPath     = "/gateway"      // Path of the lobby websocket on a gateway host
+	gameGatewayPath = "/game-gateway" // Path of the game websocket on a gateway host
+)
+
+// GatewayCandidate is a gateway probed by LookupGateway.
+type GatewayCandidate struct {
+	Address    *ServerAddress
+	Profile    *ServerProfile // Profile of the server the gateway was found for, nil if it is not registered
+	Discovered bool           // Whether the gateway was read from the server config rather than given to LookupGateway
+	Latency    time.Duration  // Time taken by the websocket handshake
+	Err        error          // Why the candidate was not usable, nil if it was
+}
+
+// LookupError is returned by LookupGateway when no candidate is usable.
+type LookupError struct {
+	Candidates []*GatewayCandidate
+}
+
+func (e *LookupError) Error() string {
+	if len(e.Candidates) == 0 {
+		return "no server"
+	}
+	reasons := make([]string, 0, len(e.Candidates))
+	for _, candidate := range e.Candidates {
+		reasons = append(reasons, fmt.Sprintf("%s: %v", candidate.Address.GatewayAddress, candidate.Err))
+	}
+	return fmt.Sprintf("no server, %s", strings.Join(reasons, "; "))
+}
+
+// Unwrap returns the error of every candidate.
+func (e *LookupError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Candidates))
+	for _, candidate := range e.Candidates {
+		errs = append(errs, candidate.Err)
+	}
+	return errs
+}
+
+// gatewayConfig is the part of the config.json published by the web client listing the gateways.
+type gatewayConfig struct {
+	Ip []struct {
+		Name       string `json:"name"`
+		RegionUrls []struct {
+			Name string `json:"name"`
+			Url  string `json:"url"`
+		} `json:"region_urls"`
+		Gateways []struct {
+			Id  string `json:"id"`
+			Url string `json:"url"`
+		} `json:"gateways"`
+	} `json:"ip"`
+}
+
+// recommendList is the response of the region urls of the gateway config.
+type recommendList struct {
+	Servers []string `json:"servers"`
+}
+
+// gatewayCandidates returns the gateways to probe, the ones discovered for each server before the server itself.
+func (majSoul *MajSoul) gatewayCandidates(ctx context.Context, serverAddressList []*ServerAddress) []*GatewayCandidate {
+	profiles := make([]*ServerProfile, len(serverAddressList))
+	for i, serverAddress := range serverAddressList {
+		profiles[i] = majSoul.Profile
+		if !profiles[i].contains(serverAddress) {
+			profiles[i] = profileOf(serverAddress)
+		}
+	}
+
+	discovered := make([][]*ServerAddress, len(serverAddressList))
+	if !majSoul.config.DisableGatewayDiscovery {
+		var wg sync.WaitGroup
+		for i, serverAddress := range serverAddressList {
+			wg.Add(1)
+			go func(i int, serverAddress *ServerAddress) {
+				defer wg.Done()
+				list, err := majSoul.discoverGateways(ctx, serverAddress, profiles[i])
+				if err != nil {
+					logger.Debug("majSoul gateway discovery failed", zap.String("server", serverAddress.ServerAddress), zap.Error(err))
+					return
+				}
+				discovered[i] = list
+			}(i, serverAddress)
+		}
+		wg.Wait()
+	}
+
+	seen := make(map[string]struct{})
+	var candidates []*GatewayCandidate
+	for i, serverAddress := range serverAddressList {
+		for _, address := range append(discovered[i], serverAddress) {
+			if _, ok := seen[address.GatewayAddress]; ok {
+				continue
+			}
+			seen[address.GatewayAddress] = struct{}{}
+			candidates = append(candidates, &GatewayCandidate{
+				Address:    address,
+				Profile:    profiles[i],
+				Discovered: address != serverAddress,
+				Latency:    0,
+				Err:        nil,
+			})
+		}
+	}
+	return candidates
+}
+
+// discoverGateways reads the gateways published in the config.json of the web client of serverAddress.
+func (majSoul *MajSoul) discoverGateways(ctx context.Context, serverAddress *ServerAddress, profile *ServerProfile) ([]*ServerAddress, error) {
+	resourceURL := resourceURL(profile, serverAddress)
+	request, err := majSoul.newRequest(resourceURL, serverAddress.ServerAddress)
+	if err != nil {
+		return nil, err
+	}
+	version, err := fetchVersion(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("version: %w", err)
+	}
+
+	downloader := resources.NewDownloader(request, "")
+	manifest, err := downloader.Manifest(ctx, version.Version)
+	if err != nil {
+		return nil, err
+	}
+	body, err := downloader.Get(ctx, manifest.Path("config.json"))
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	config := new(gatewayConfig)
+	if err = json.Unmarshal(body, config); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	var list []*ServerAddress
+	for _, ip := range config.Ip {
+		for _, gateway := range ip.Gateways {
+			if address := gatewayAddress(serverAddress, gateway.Url); address != nil {
+				list = append(list, address)
+			}
+		}
+		for _, region := range ip.RegionUrls {
+			servers, err := majSoul.recommendList(ctx, region.Url, serverAddress.ServerAddress)
+			if err != nil {
+				logger.Debug("majSoul recommend list failed", zap.String("url", region.Url), zap.Error(err))
+				continue
+			}
+			for _, server := range servers {
+				if address := gatewayAddress(serverAddress, server); address != nil {
+					list = append(list, address)
+				}
+			}
+		}
+	}
+	return list, nil
+}
+
+// recommendList returns the gateway hosts recommended by a region url of the gateway config.
+func (majSoul *MajSoul) recommendList(ctx context.Context, regionURL, origin string) ([]string, error) {
+	u, err := url.Parse(regionURL)
+	if err != nil {
+		return nil, err
+	}
+	request, err := majSoul.newRequest(fmt.Sprintf("%s://%s", u.Scheme, u.Host), origin)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	query.Set("service", "ws-gateway")
+	query.Set("protocol", "ws")
+	query.Set("ssl", "true")
+	body, err := request.GetContext(ctx, fmt.Sprintf("%s?%s", strings.TrimPrefix(u.Path, "/"), query.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	list := new(recommendList)
+	if err = json.Unmarshal(body, list); err != nil {
+		return nil, err
+	}
+	return list.Servers, nil
+}
+
+// gatewayAddress returns the address of the gateway host, given as a url or host[:port], for the web client of serverAddress.
+func gatewayAddress(serverAddress *ServerAddress, gateway string) *ServerAddress {
+	host := gateway
+	if u, err := url.Parse(gateway); err == nil && len(u.Host) != 0 {
+		host = u.Host
+	}
+	host = strings.TrimSuffix(host, "/")
+	if len(host) == 0 {
+		return nil
+	}
+	return &ServerAddress{
+		ServerAddress:  serverAddress.ServerAddress,
+		GatewayAddress: fmt.Sprintf("wss://%s%s", host, gatewayPath),
+		GameAddress:    fmt.Sprintf("wss://%s%s", host, gameGatewayPath),
+	}
+}
+
+// gatewayProbe is the outcome of probing a candidate, request, version and conn are set if it succeeded.
+type gatewayProbe struct {
+	candidate *GatewayCandidate
+	request   *network.Request
+	version   *Version
+	conn      *network.WsClient
+}
+
+// probeGateway fetches the version from the server of candidate and connects to its gateway.
+func (majSoul *MajSoul) probeGateway(ctx context.Context, candidate *GatewayCandidate) *gatewayProbe {
+	probe := &gatewayProbe{
+		candidate: candidate,
+		request:   nil,
+		version:   nil,
+		conn:      nil,
+	}
+	serverAddress := candidate.Address
+
+	request, err := majSoul.newRequest(resourceURL(candidate.Profile, serverAddress), serverAddress.ServerAddress)
+	if err != nil {
+		candidate.Err = err
+		return probe
+	}
+	version, err := fetchVersion(ctx, request)
+	if err != nil {
+		candidate.Err = fmt.Errorf("version: %w", err)
+		return probe
+	}
+
+	conn, err := majSoul.newWsClient(serverAddress.GatewayAddress, serverAddress.ServerAddress)
+	if err != nil {
+		candidate.Err = err
+		return probe
+	}
+	majSoul.bindReconnectHandlers(SourceLobby, conn)
+	start := time.Now()
+	if err = conn.Connect(ctx); err != nil {
+		_ = conn.Close()
+		candidate.Err = err
+		return probe
+	}
+	candidate.Latency = time.Since(start)
+
+	probe.request = request
+	probe.version = version
+	probe.conn = conn
+	return probe
+}
@@ -3,7 +3,9 @@ module github.com/constellation39/majsoul
 go 1.20
 
 require (
+	github.com/andybalholm/brotli v1.0.6
 	go.uber.org/zap v1.24.0
+	golang.org/x/net v0.9.0
 	google.golang.org/grpc v1.56.2
 	google.golang.org/protobuf v1.31.0
 	nhooyr.io/websocket v1.8.7
@@ -14,7 +16,6 @@ require (
 	github.com/klauspost/compress v1.10.3 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
-	golang.org/x/net v0.9.0 // indirect
 	golang.org/x/sys v0.7.0 // indirect
 	golang.org/x/text v0.9.0 // indirect
 	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
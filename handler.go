@@ -0,0 +1,324 @@
+package majsoul
+
+import (
+	"errors"
+	"fmt"
+	"github.com/constellation39/majsoul/logger"
+	"github.com/constellation39/majsoul/message"
+	"github.com/constellation39/majsoul/network"
+	"github.com/constellation39/majsoul/utils"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// messagePackage is the protobuf package of every message sent by the server.
+const messagePackage = "lq"
+
+// actionPrototypeName is the notify that carries the encoded game actions.
+var actionPrototypeName = (*message.ActionPrototype)(nil).ProtoReflect().Descriptor().FullName()
+
+// subscribe is a handler registered for a single message type.
+type subscribe struct {
+	messageType protoreflect.MessageType // nil for handlers registered by name with OnName
+	call        func(majSoul *MajSoul, msg proto.Message) error
+}
+
+// Subscription is the handle returned by On. It can be used to cancel the registered handler.
+type Subscription struct {
+	majSoul *MajSoul
+	name    protoreflect.FullName
+	sub     *subscribe
+}
+
+// Name returns the full protobuf name of the message the subscription listens to, e.g. "lq.ActionDiscardTile".
+func (s *Subscription) Name() string {
+	return string(s.name)
+}
+
+// Cancel removes the handler. It is equivalent to majSoul.Unsubscribe(s).
+func (s *Subscription) Cancel() {
+	s.majSoul.Unsubscribe(s)
+}
+
+// On registers callback for the notify or action whose message type is T.
+// T must be a generated message pointer such as *message.ActionDiscardTile;
+// the handler is keyed by the full protobuf name of T.
+// Any number of callbacks may be registered for the same message, they are called in registration order
+// and share the decoded message, so callbacks must not modify it.
+func On[T proto.Message](majSoul *MajSoul, callback func(*MajSoul, T)) *Subscription {
+	if callback == nil {
+		panic("majsoul: On callback is nil")
+	}
+	return OnE(majSoul, func(majSoul *MajSoul, msg T) error {
+		callback(majSoul, msg)
+		return nil
+	})
+}
+
+// OnE is like On but the callback may return an error, which is reported to the OnHandlerError callback.
+func OnE[T proto.Message](majSoul *MajSoul, callback func(*MajSoul, T) error) *Subscription {
+	if callback == nil {
+		panic("majsoul: OnE callback is nil")
+	}
+	var zero T
+	messageType := zero.ProtoReflect().Type()
+	s := &Subscription{
+		majSoul: majSoul,
+		name:    messageType.Descriptor().FullName(),
+		sub: &subscribe{
+			messageType: messageType,
+			call: func(majSoul *MajSoul, msg proto.Message) error {
+				return callback(majSoul, msg.(T))
+			},
+		},
+	}
+	majSoul.handleMutex.Lock()
+	defer majSoul.handleMutex.Unlock()
+	subs := majSoul.handleMap[s.name]
+	// copy on write so that dispatch can iterate without holding the lock
+	majSoul.handleMap[s.name] = append(subs[:len(subs):len(subs)], s.sub)
+	return s
+}
+
+// Unsubscribe removes the handlers of the given subscriptions. Unknown or already removed subscriptions are ignored.
+func (majSoul *MajSoul) Unsubscribe(subscriptions ...*Subscription) {
+	majSoul.handleMutex.Lock()
+	defer majSoul.handleMutex.Unlock()
+	for _, subscription := range subscriptions {
+		if subscription == nil || subscription.majSoul != majSoul {
+			continue
+		}
+		subs := majSoul.handleMap[subscription.name]
+		for i, sub := range subs {
+			if sub != subscription.sub {
+				continue
+			}
+			if len(subs) == 1 {
+				delete(majSoul.handleMap, subscription.name)
+				break
+			}
+			remain := make([]*subscribe, 0, len(subs)-1)
+			remain = append(remain, subs[:i]...)
+			remain = append(remain, subs[i+1:]...)
+			majSoul.handleMap[subscription.name] = remain
+			break
+		}
+	}
+}
+
+// subscribers returns the handlers registered for name. The returned slice must not be modified.
+func (majSoul *MajSoul) subscribers(name protoreflect.FullName) []*subscribe {
+	majSoul.handleMutex.RLock()
+	defer majSoul.handleMutex.RUnlock()
+	return majSoul.handleMap[name]
+}
+
+// fullName converts the name carried by a Wrapper (".lq.NotifyRoomGameStart") or an
+// ActionPrototype ("ActionDiscardTile") into a full protobuf name.
+func fullName(name string) protoreflect.FullName {
+	name = strings.TrimPrefix(name, ".")
+	if !strings.Contains(name, ".") {
+		return protoreflect.FullName(messagePackage).Append(protoreflect.Name(name))
+	}
+	return protoreflect.FullName(name)
+}
+
+// HandlerError describes a message that could not be decoded or whose handler failed.
+type HandlerError struct {
+	Name    string           // Full name of the message, e.g. "lq.ActionDiscardTile"
+	Wrapper *message.Wrapper // Raw wrapper received from the server, for actions the wrapper of the ActionPrototype
+	Err     error            // Decode error, error returned by the handler or ErrHandlerPanic
+	Stack   []byte           // Stack of the panicking goroutine, nil unless Err wraps ErrHandlerPanic
+}
+
+// ErrHandlerPanic is wrapped by HandlerError.Err when a handler panics.
+var ErrHandlerPanic = errors.New("majsoul: handler panic")
+
+func (e *HandlerError) Error() string {
+	return fmt.Sprintf("majsoul: handle %s: %v", e.Name, e.Err)
+}
+
+func (e *HandlerError) Unwrap() error {
+	return e.Err
+}
+
+// OnHandlerError sets the callback for messages that fail to decode and handlers that return an error or panic.
+// err is always a *HandlerError. Without a callback such errors are logged.
+func (majSoul *MajSoul) OnHandlerError(callback func(name string, err error)) {
+	majSoul.handleMutex.Lock()
+	defer majSoul.handleMutex.Unlock()
+	majSoul.onHandlerErrorCallBack = callback
+}
+
+func (majSoul *MajSoul) handlerError(name protoreflect.FullName, wrapper *message.Wrapper, err error, stack []byte) {
+	handlerErr := &HandlerError{
+		Name:    string(name),
+		Wrapper: wrapper,
+		Err:     err,
+		Stack:   stack,
+	}
+	majSoul.handleMutex.RLock()
+	callback := majSoul.onHandlerErrorCallBack
+	majSoul.handleMutex.RUnlock()
+	if callback == nil {
+		logger.Error("handle message failed", zap.String("name", handlerErr.Name), zap.Error(err))
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("OnHandlerError callback panic", zap.String("name", handlerErr.Name), zap.Any("panic", r))
+		}
+	}()
+	callback(handlerErr.Name, handlerErr)
+}
+
+// protect calls fn, turning a panic into an error wrapping ErrHandlerPanic.
+func protect(fn func() error) (stack []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrHandlerPanic, r)
+			stack = debug.Stack()
+		}
+	}()
+	return nil, fn()
+}
+
+// Handler processes a decoded event.
+type Handler func(majSoul *MajSoul, event *Event) error
+
+// Middleware wraps the Handler that calls the subscribers and feeds the event streams.
+// A middleware may inspect or replace the event, skip next to drop it, or return an error
+// which is reported to the OnHandlerError callback.
+type Middleware func(next Handler) Handler
+
+// Use appends middlewares to the chain every notify and action goes through.
+// Middlewares run in the order they were added, the first one is the outermost.
+func (majSoul *MajSoul) Use(middlewares ...Middleware) {
+	majSoul.handleMutex.Lock()
+	defer majSoul.handleMutex.Unlock()
+	majSoul.middlewares = append(majSoul.middlewares[:len(majSoul.middlewares):len(majSoul.middlewares)], middlewares...)
+	handler := Handler((*MajSoul).handle)
+	for i := len(majSoul.middlewares) - 1; i >= 0; i-- {
+		handler = majSoul.middlewares[i](handler)
+	}
+	majSoul.handler = handler
+}
+
+// handlerChain returns the handler with every middleware applied, nil when no middleware is used.
+func (majSoul *MajSoul) handlerChain() Handler {
+	majSoul.handleMutex.RLock()
+	defer majSoul.handleMutex.RUnlock()
+	return majSoul.handler
+}
+
+// handle is the innermost Handler, it calls the subscribers in registration order and publishes the event.
+func (majSoul *MajSoul) handle(event *Event) error {
+	name := protoreflect.FullName(event.Name)
+	for _, sub := range majSoul.subscribers(name) {
+		if stack, err := protect(func() error { return sub.call(majSoul, event.Message) }); err != nil {
+			majSoul.handlerError(name, event.Wrapper, err, stack)
+		}
+	}
+	majSoul.publish(event)
+	return nil
+}
+
+// messageType returns the type to decode name into, preferring the type the handlers were registered with,
+// then the generated type and last the type of the Registry.
+func (majSoul *MajSoul) messageType(name protoreflect.FullName, subs []*subscribe) (protoreflect.MessageType, bool) {
+	for _, sub := range subs {
+		if sub.messageType != nil {
+			return sub.messageType, true
+		}
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err == nil {
+		return mt, true
+	}
+	if registry := majSoul.Registry(); registry != nil {
+		return registry.FindMessageByName(string(name))
+	}
+	return nil, false
+}
+
+// dispatch decodes data into the message type of event.Name and passes the event through the middlewares
+// to the handlers and event streams.
+// Failures are reported to the OnHandlerError callback.
+// It reports false when nobody is interested in the message or its type is unknown.
+func (majSoul *MajSoul) dispatch(event *Event, data []byte) bool {
+	name := protoreflect.FullName(event.Name)
+	subs := majSoul.subscribers(name)
+	chain := majSoul.handlerChain()
+	if len(subs) == 0 && len(majSoul.eventStreams()) == 0 && chain == nil {
+		return false
+	}
+	mt, ok := majSoul.messageType(name, subs)
+	if !ok {
+		return false
+	}
+	msg := mt.New().Interface()
+	err := proto.Unmarshal(data, msg)
+	if err != nil {
+		majSoul.handlerError(name, event.Wrapper, fmt.Errorf("proto unmarshal error %w", err), nil)
+		return true
+	}
+	event.Message = msg
+	if chain == nil {
+		_ = majSoul.handle(event)
+		return true
+	}
+	if stack, err := protect(func() error { return chain(majSoul, event) }); err != nil {
+		majSoul.handlerError(name, event.Wrapper, err, stack)
+	}
+	return true
+}
+
+func (majSoul *MajSoul) callHandleMap(source Source, notify *network.Notify) {
+	wrapper := notify.Wrapper
+	name := fullName(wrapper.Name)
+	if name == actionPrototypeName {
+		actionPrototype := new(message.ActionPrototype)
+		err := proto.Unmarshal(wrapper.Data, actionPrototype)
+		if err != nil {
+			majSoul.handlerError(name, wrapper, fmt.Errorf("proto unmarshal error %w", err), nil)
+			return
+		}
+		majSoul.handleAction(source, notify.Time, wrapper, actionPrototype)
+		return
+	}
+	event := &Event{
+		Source:  source,
+		Name:    string(name),
+		Time:    notify.Time,
+		Wrapper: wrapper,
+	}
+	if !majSoul.dispatch(event, wrapper.Data) {
+		logger.Info("unregistered notify", zap.String("name", wrapper.Name))
+	}
+}
+
+// ActionPrototype feeds an already received game action, such as one of GameRestore.Actions, into the dispatch path
+// as if it came from the game connection, its handlers and middlewares are run.
+// actionPrototype is not modified, its data is decoded in a copy.
+func (majSoul *MajSoul) ActionPrototype(actionPrototype *message.ActionPrototype) {
+	majSoul.handleAction(SourceGame, time.Now(), nil, proto.Clone(actionPrototype).(*message.ActionPrototype))
+}
+
+func (majSoul *MajSoul) handleAction(source Source, receiveTime time.Time, wrapper *message.Wrapper, actionPrototype *message.ActionPrototype) {
+	utils.DecodeActionPrototype(actionPrototype)
+	event := &Event{
+		Source:  source,
+		Name:    string(fullName(actionPrototype.Name)),
+		Time:    receiveTime,
+		Step:    actionPrototype.Step,
+		Wrapper: wrapper,
+	}
+	if !majSoul.dispatch(event, actionPrototype.Data) {
+		logger.Debug("unregistered action", zap.String("name", actionPrototype.Name))
+	}
+}
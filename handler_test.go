@@ -0,0 +1,35 @@
+package majsoul
+
+import (
+	"bytes"
+	"github.com/constellation39/majsoul/message"
+	"github.com/constellation39/majsoul/utils"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestActionPrototypeKeepsArgument(t *testing.T) {
+	data, err := proto.Marshal(&message.ActionDiscardTile{Tile: "1m", Seat: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	action := &message.ActionPrototype{Step: 3, Name: "ActionDiscardTile", Data: data}
+	// the server sends the data encoded, encoding is the same xor as decoding
+	utils.DecodeActionPrototype(action)
+	encoded := append([]byte(nil), action.Data...)
+
+	majSoul := NewMajSoul(nil)
+	var tiles []string
+	On(majSoul, func(_ *MajSoul, discard *message.ActionDiscardTile) {
+		tiles = append(tiles, discard.Tile)
+	})
+	majSoul.ActionPrototype(action)
+	majSoul.ActionPrototype(action)
+
+	if !bytes.Equal(action.Data, encoded) {
+		t.Error("ActionPrototype modified the data of its argument")
+	}
+	if len(tiles) != 2 || tiles[0] != "1m" || tiles[1] != "1m" {
+		t.Errorf("handled tiles = %v, want [1m 1m]", tiles)
+	}
+}
@@ -0,0 +1,140 @@
+package majsoul
+
+import (
+	"context"
+	"github.com/constellation39/majsoul/logger"
+	"github.com/constellation39/majsoul/message"
+	"github.com/constellation39/majsoul/network"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const (
+	defaultKeepaliveInterval = time.Second * 30                   // Used when Config.KeepaliveInterval is 0
+	maxFailedHeartbeats      = 2                                  // Consecutive failed heartbeats before the lobby connection is dropped
+	loginBeatContract        = "DF2vkXCnfeXp4WoGSBGNcJBufZiMN3UP" // Contract the web client sends with loginBeat
+)
+
+// Stats reports the health of the connections measured by the keepalive.
+type Stats struct {
+	HeartbeatLatency time.Duration // Round trip of the last heatbeat RPC on the lobby connection
+	LastHeartbeat    time.Time     // Time of the last successful heatbeat RPC
+	FailedHeartbeats int           // Consecutive failed heatbeat RPCs
+	LobbyPing        time.Duration // Round trip of the last websocket ping on the lobby connection
+	GamePing         time.Duration // Round trip of the last websocket ping on the game connection
+}
+
+// keepalive holds the state of the heartbeat scheduler.
+type keepalive struct {
+	mutex  sync.Mutex // Guards every field
+	cancel context.CancelFunc
+	stats  Stats
+}
+
+// keepaliveInterval returns the interval of heartbeats and pings, 0 if keepalive is disabled.
+func (majSoul *MajSoul) keepaliveInterval() time.Duration {
+	switch {
+	case majSoul.config.KeepaliveInterval < 0:
+		return 0
+	case majSoul.config.KeepaliveInterval == 0:
+		return defaultKeepaliveInterval
+	default:
+		return majSoul.config.KeepaliveInterval
+	}
+}
+
+// Stats returns the latest keepalive measurements.
+func (majSoul *MajSoul) Stats() Stats {
+	majSoul.keepalive.mutex.Lock()
+	stats := majSoul.keepalive.stats
+	majSoul.keepalive.mutex.Unlock()
+	if conn := majSoul.lobbyConn(); conn != nil {
+		stats.LobbyPing = conn.Latency()
+	}
+	if conn := majSoul.gameConn(); conn != nil {
+		stats.GamePing = conn.Latency()
+	}
+	return stats
+}
+
+// startKeepalive starts sending heatbeat and loginBeat on the lobby connection, replacing a running scheduler.
+func (majSoul *MajSoul) startKeepalive() {
+	interval := majSoul.keepaliveInterval()
+	if interval == 0 {
+		return
+	}
+	parent := majSoul.config.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+
+	majSoul.keepalive.mutex.Lock()
+	if majSoul.keepalive.cancel != nil {
+		majSoul.keepalive.cancel()
+	}
+	majSoul.keepalive.cancel = cancel
+	majSoul.keepalive.mutex.Unlock()
+
+	go majSoul.runKeepalive(ctx, interval)
+}
+
+// stopKeepalive stops the running scheduler, if any.
+func (majSoul *MajSoul) stopKeepalive() {
+	majSoul.keepalive.mutex.Lock()
+	defer majSoul.keepalive.mutex.Unlock()
+	if majSoul.keepalive.cancel != nil {
+		majSoul.keepalive.cancel()
+		majSoul.keepalive.cancel = nil
+	}
+}
+
+func (majSoul *MajSoul) runKeepalive(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if conn := majSoul.lobbyConn(); conn == nil || !conn.Connected() {
+			// reconnecting, failed heartbeats would only abort the next connection
+			continue
+		}
+		majSoul.heartbeat(ctx, interval)
+	}
+}
+
+// heartbeat sends one heatbeat and loginBeat, the lobby connection is dropped after maxFailedHeartbeats failures.
+func (majSoul *MajSoul) heartbeat(ctx context.Context, timeout time.Duration) {
+	lobbyClient := majSoul.lobbyClient()
+	start := time.Now()
+	_, err := lobbyClient.Heatbeat(ctx, &message.ReqHeatBeat{NoOperationCounter: 0}, network.CallTimeout(timeout), network.NoRetry())
+	latency := time.Since(start)
+	if err == nil {
+		_, err = lobbyClient.LoginBeat(ctx, &message.ReqLoginBeat{Contract: loginBeatContract}, network.CallTimeout(timeout), network.NoRetry())
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	majSoul.keepalive.mutex.Lock()
+	if err == nil {
+		majSoul.keepalive.stats.HeartbeatLatency = latency
+		majSoul.keepalive.stats.LastHeartbeat = time.Now()
+		majSoul.keepalive.stats.FailedHeartbeats = 0
+	} else {
+		majSoul.keepalive.stats.FailedHeartbeats++
+	}
+	failed := majSoul.keepalive.stats.FailedHeartbeats
+	majSoul.keepalive.mutex.Unlock()
+
+	if err != nil {
+		logger.Warn("majSoul heartbeat failed", zap.Int("failed", failed), zap.Error(err))
+		if conn := majSoul.lobbyConn(); conn != nil && failed >= maxFailedHeartbeats {
+			conn.Abort("heartbeat timeout")
+		}
+	}
+}
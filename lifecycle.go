@@ -0,0 +1,253 @@
+package majsoul
+
+import (
+	"context"
+	"errors"
+	"github.com/constellation39/majsoul/logger"
+	"github.com/constellation39/majsoul/network"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by LookupGateway, ConnGame and the calls of LobbyClient and FastTestClient after Close.
+// It is network.ErrClosed, so that a single sentinel is checked.
+var ErrClosed = network.ErrClosed
+
+// stateChangesBuffer is the buffer of the channels returned by StateChanges, the oldest change is dropped when full.
+const stateChangesBuffer = 16
+
+// State is the connection state of a MajSoul.
+type State int
+
+const (
+	StateDisconnected  State = iota // No lobby connection, the initial state and the state after Close
+	StateConnecting                 // LookupGateway is looking for a server
+	StateConnected                  // The lobby connection is up but not logged in
+	StateAuthenticated              // Logged in
+	StateInGame                     // Logged in and connected to the game of the session
+	StateReconnecting               // A lost lobby or game connection is being reestablished
+)
+
+func (s State) String() string {
+	switch s {
+	case StateDisconnected:
+		return "disconnected"
+	case StateConnecting:
+		return "connecting"
+	case StateConnected:
+		return "connected"
+	case StateAuthenticated:
+		return "authenticated"
+	case StateInGame:
+		return "in game"
+	case StateReconnecting:
+		return "reconnecting"
+	default:
+		return "unknown"
+	}
+}
+
+// StateChange is sent by StateChanges on each transition.
+type StateChange struct {
+	From State
+	To   State
+	Err  error // Why the connection was lost or given up, nil otherwise
+	Time time.Time
+}
+
+// lifecycle holds the connection state and the shutdown of a MajSoul.
+type lifecycle struct {
+	mutex     sync.Mutex // Guards state, watchers and closed, and orders Close with adopt
+	state     State
+	watchers  []chan StateChange
+	closed    bool
+	closeOnce sync.Once
+	closeErr  error         // set before done is closed
+	closing   chan struct{} // closed when Close is called
+	done      chan struct{} // closed once Close finished
+	readers   sync.WaitGroup
+}
+
+func newLifecycle() lifecycle {
+	return lifecycle{
+		mutex:     sync.Mutex{},
+		state:     StateDisconnected,
+		watchers:  nil,
+		closed:    false,
+		closeOnce: sync.Once{},
+		closeErr:  nil,
+		closing:   make(chan struct{}),
+		done:      make(chan struct{}),
+		readers:   sync.WaitGroup{},
+	}
+}
+
+// State returns the current connection state.
+func (majSoul *MajSoul) State() State {
+	majSoul.lifecycle.mutex.Lock()
+	defer majSoul.lifecycle.mutex.Unlock()
+	return majSoul.lifecycle.state
+}
+
+// StateChanges returns a channel receiving every following state transition, it is closed by Close.
+// The oldest change is dropped when the channel is full, State always returns the current state.
+func (majSoul *MajSoul) StateChanges() <-chan StateChange {
+	changes := make(chan StateChange, stateChangesBuffer)
+	majSoul.lifecycle.mutex.Lock()
+	defer majSoul.lifecycle.mutex.Unlock()
+	if majSoul.lifecycle.closed {
+		close(changes)
+		return changes
+	}
+	majSoul.lifecycle.watchers = append(majSoul.lifecycle.watchers, changes)
+	return changes
+}
+
+// Done returns a channel closed once Close finished.
+func (majSoul *MajSoul) Done() <-chan struct{} {
+	return majSoul.lifecycle.done
+}
+
+func (majSoul *MajSoul) setState(state State, err error) {
+	majSoul.lifecycle.mutex.Lock()
+	defer majSoul.lifecycle.mutex.Unlock()
+	if majSoul.lifecycle.closed || majSoul.lifecycle.state == state {
+		return
+	}
+	change := StateChange{
+		From: majSoul.lifecycle.state,
+		To:   state,
+		Err:  err,
+		Time: time.Now(),
+	}
+	majSoul.lifecycle.state = state
+	logger.Debug("majSoul state changed", zap.Stringer("from", change.From), zap.Stringer("to", change.To), zap.Error(err))
+	for _, watcher := range majSoul.lifecycle.watchers {
+		sendStateChange(watcher, change)
+	}
+}
+
+// sendStateChange sends change to watcher, dropping the oldest change if it is full.
+func sendStateChange(watcher chan StateChange, change StateChange) {
+	for {
+		select {
+		case watcher <- change:
+			return
+		default:
+		}
+		select {
+		case <-watcher:
+		default:
+		}
+	}
+}
+
+// refreshState derives the state from the connections and the session, err is reported with the change.
+func (majSoul *MajSoul) refreshState(err error) {
+	majSoul.setState(majSoul.currentState(), err)
+}
+
+func (majSoul *MajSoul) currentState() State {
+	lobby := majSoul.lobbyConn()
+	if lobby == nil || lobby.Closed() {
+		return StateDisconnected
+	}
+	if !lobby.Connected() {
+		return StateReconnecting
+	}
+
+	majSoul.session.mutex.Lock()
+	authenticated := majSoul.session.authenticated
+	inGame := len(majSoul.session.gameUuid) != 0
+	majSoul.session.mutex.Unlock()
+	if !authenticated {
+		return StateConnected
+	}
+
+	game := majSoul.gameConn()
+	if game == nil || game.Closed() {
+		return StateAuthenticated
+	}
+	if !game.Connected() {
+		return StateReconnecting
+	}
+	if inGame {
+		return StateInGame
+	}
+	return StateAuthenticated
+}
+
+// isClosing reports whether Close has been called.
+func (majSoul *MajSoul) isClosing() bool {
+	select {
+	case <-majSoul.lifecycle.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// adopt runs store, which keeps a new connection and starts its reader, unless Close was called.
+// It is ordered with Close, so that the shutdown closes and waits for what store kept.
+// It reports false when Close was called, the connection must then be closed by the caller.
+func (majSoul *MajSoul) adopt(store func()) bool {
+	majSoul.lifecycle.mutex.Lock()
+	defer majSoul.lifecycle.mutex.Unlock()
+	if majSoul.isClosing() {
+		return false
+	}
+	store()
+	return true
+}
+
+// Close shuts down the game and lobby connections and stops reconnecting and the keepalive.
+// Notifies already received are still passed to the handlers, then the Events and StateChanges channels are closed.
+// It returns ctx.Err() if ctx is done first, the shutdown goes on in the background and Done reports its end.
+func (majSoul *MajSoul) Close(ctx context.Context) error {
+	majSoul.lifecycle.closeOnce.Do(func() {
+		majSoul.lifecycle.mutex.Lock()
+		close(majSoul.lifecycle.closing)
+		majSoul.lifecycle.mutex.Unlock()
+		go majSoul.shutdown()
+	})
+	select {
+	case <-majSoul.lifecycle.done:
+		return majSoul.lifecycle.closeErr
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (majSoul *MajSoul) shutdown() {
+	majSoul.stopKeepalive()
+	majSoul.stopVersionWatcher()
+
+	var errs []error
+	if conn := majSoul.gameConn(); conn != nil {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if conn := majSoul.lobbyConn(); conn != nil {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// the readers return once the notifies left in the closed connections are handled
+	majSoul.lifecycle.readers.Wait()
+	majSoul.closeEventStreams()
+	majSoul.closeIdleConnections()
+
+	majSoul.setState(StateDisconnected, nil)
+	majSoul.lifecycle.mutex.Lock()
+	majSoul.lifecycle.closed = true
+	for _, watcher := range majSoul.lifecycle.watchers {
+		close(watcher)
+	}
+	majSoul.lifecycle.watchers = nil
+	majSoul.lifecycle.mutex.Unlock()
+
+	majSoul.lifecycle.closeErr = errors.Join(errs...)
+	close(majSoul.lifecycle.done)
+}
@@ -0,0 +1,212 @@
+package liqi
+
+import (
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"strings"
+)
+
+// scalarTypes maps the scalar types of .proto source to their descriptor type.
+var scalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"double":   descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":    descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+// Descriptor returns the descriptor protoc would produce for the .proto source of file, named name, e.g. "liqi.proto".
+func (file *File) Descriptor(name string) (*descriptorpb.FileDescriptorProto, error) {
+	builder := &descriptorBuilder{
+		kinds: make(map[string]descriptorpb.FieldDescriptorProto_Type),
+	}
+	scope := ""
+	if len(file.Package) != 0 {
+		scope = "." + file.Package
+	}
+	builder.index(scope, file.Definitions)
+
+	fd := &descriptorpb.FileDescriptorProto{
+		Name:   proto.String(name),
+		Syntax: proto.String("proto3"),
+	}
+	if len(file.Package) != 0 {
+		fd.Package = proto.String(file.Package)
+	}
+	for _, definition := range file.Definitions {
+		switch definition := definition.(type) {
+		case *Message:
+			message, err := builder.message(scope, definition)
+			if err != nil {
+				return nil, err
+			}
+			fd.MessageType = append(fd.MessageType, message)
+		case *Enum:
+			fd.EnumType = append(fd.EnumType, enumDescriptor(definition))
+		case *Service:
+			service, err := builder.service(scope, definition)
+			if err != nil {
+				return nil, err
+			}
+			fd.Service = append(fd.Service, service)
+		}
+	}
+	if _, err := protodesc.NewFile(fd, nil); err != nil {
+		return nil, fmt.Errorf("build descriptor error: %w", err)
+	}
+	return fd, nil
+}
+
+// FileDescriptor returns the descriptor of file, named name, ready to be used with dynamicpb.
+func (file *File) FileDescriptor(name string) (protoreflect.FileDescriptor, error) {
+	fd, err := file.Descriptor(name)
+	if err != nil {
+		return nil, err
+	}
+	return protodesc.NewFile(fd, nil)
+}
+
+type descriptorBuilder struct {
+	kinds map[string]descriptorpb.FieldDescriptorProto_Type // Full names of messages and enums, with a leading dot
+}
+
+func (builder *descriptorBuilder) index(scope string, definitions []Definition) {
+	for _, definition := range definitions {
+		fullName := fmt.Sprintf("%s.%s", scope, definition.DefinitionName())
+		switch definition := definition.(type) {
+		case *Message:
+			builder.kinds[fullName] = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+			builder.index(fullName, definition.Nested)
+		case *Enum:
+			builder.kinds[fullName] = descriptorpb.FieldDescriptorProto_TYPE_ENUM
+		}
+	}
+}
+
+// resolve finds typeName from scope outwards like protoc, it returns the full name and kind of the type.
+func (builder *descriptorBuilder) resolve(scope, typeName string) (string, descriptorpb.FieldDescriptorProto_Type, bool) {
+	if strings.HasPrefix(typeName, ".") {
+		kind, ok := builder.kinds[typeName]
+		return typeName, kind, ok
+	}
+	for {
+		fullName := fmt.Sprintf("%s.%s", scope, typeName)
+		if kind, ok := builder.kinds[fullName]; ok {
+			return fullName, kind, true
+		}
+		if len(scope) == 0 {
+			return "", 0, false
+		}
+		scope = scope[:strings.LastIndexByte(scope, '.')]
+	}
+}
+
+func (builder *descriptorBuilder) message(scope string, message *Message) (*descriptorpb.DescriptorProto, error) {
+	fullName := fmt.Sprintf("%s.%s", scope, message.Name)
+	descriptor := &descriptorpb.DescriptorProto{
+		Name: proto.String(message.Name),
+	}
+	for _, field := range message.Fields {
+		label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		if field.Rule == "repeated" {
+			label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		}
+		fieldDescriptor := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(field.ProtoName()),
+			Number:   proto.Int32(field.ID),
+			Label:    label.Enum(),
+			JsonName: proto.String(jsonName(field.ProtoName())),
+		}
+		if kind, ok := scalarTypes[field.Type]; ok {
+			fieldDescriptor.Type = kind.Enum()
+		} else {
+			typeName, kind, ok := builder.resolve(fullName, field.Type)
+			if !ok {
+				return nil, fmt.Errorf("build descriptor error: %s.%s: unknown type %s", fullName[1:], field.Name, field.Type)
+			}
+			fieldDescriptor.Type = kind.Enum()
+			fieldDescriptor.TypeName = proto.String(typeName)
+		}
+		descriptor.Field = append(descriptor.Field, fieldDescriptor)
+	}
+	for _, nested := range message.Nested {
+		switch nested := nested.(type) {
+		case *Message:
+			nestedDescriptor, err := builder.message(fullName, nested)
+			if err != nil {
+				return nil, err
+			}
+			descriptor.NestedType = append(descriptor.NestedType, nestedDescriptor)
+		case *Enum:
+			descriptor.EnumType = append(descriptor.EnumType, enumDescriptor(nested))
+		}
+	}
+	return descriptor, nil
+}
+
+func enumDescriptor(enum *Enum) *descriptorpb.EnumDescriptorProto {
+	descriptor := &descriptorpb.EnumDescriptorProto{
+		Name: proto.String(enum.Name),
+	}
+	for _, value := range enum.Values {
+		descriptor.Value = append(descriptor.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(value.Name),
+			Number: proto.Int32(value.Number),
+		})
+	}
+	return descriptor
+}
+
+func (builder *descriptorBuilder) service(scope string, service *Service) (*descriptorpb.ServiceDescriptorProto, error) {
+	descriptor := &descriptorpb.ServiceDescriptorProto{
+		Name: proto.String(service.Name),
+	}
+	for _, method := range service.Methods {
+		input, kind, ok := builder.resolve(scope, method.RequestType)
+		if !ok || kind != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			return nil, fmt.Errorf("build descriptor error: %s.%s: unknown request type %s", service.Name, method.Name, method.RequestType)
+		}
+		output, kind, ok := builder.resolve(scope, method.ResponseType)
+		if !ok || kind != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			return nil, fmt.Errorf("build descriptor error: %s.%s: unknown response type %s", service.Name, method.Name, method.ResponseType)
+		}
+		descriptor.Method = append(descriptor.Method, &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(method.Name),
+			InputType:  proto.String(input),
+			OutputType: proto.String(output),
+		})
+	}
+	return descriptor, nil
+}
+
+// jsonName returns the json_name protoc gives to a field: underscores are removed and the letter after them upper cased.
+func jsonName(name string) string {
+	var builder strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper && 'a' <= r && r <= 'z':
+			builder.WriteRune(r - 'a' + 'A')
+			upper = false
+		default:
+			builder.WriteRune(r)
+			upper = false
+		}
+	}
+	return builder.String()
+}
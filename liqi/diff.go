@@ -0,0 +1,112 @@
+package liqi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Diff lists the rpcs, messages and fields added or removed between two versions of liqi.json.
+// A field whose type, rule or number changed is reported as removed then added.
+type Diff struct {
+	AddedRPCs       []string // e.g. "lq.Lobby.fetchInfo (ReqCommon) returns (ResFetchInfo)"
+	RemovedRPCs     []string
+	AddedMessages   []string // e.g. "lq.NotifyGamePause"
+	RemovedMessages []string
+	AddedFields     []string // e.g. "lq.ReqLogin.tag string = 7", only for messages present in both versions
+	RemovedFields   []string
+}
+
+// Compare returns what changed from old to file.
+func (file *File) Compare(old *File) *Diff {
+	oldRPCs, oldMessages, oldFields := old.signatures()
+	newRPCs, newMessages, newFields := file.signatures()
+	diff := &Diff{
+		AddedRPCs:       difference(newRPCs, oldRPCs),
+		RemovedRPCs:     difference(oldRPCs, newRPCs),
+		AddedMessages:   difference(newMessages, oldMessages),
+		RemovedMessages: difference(oldMessages, newMessages),
+		AddedFields:     nil,
+		RemovedFields:   nil,
+	}
+	for message, fields := range newFields {
+		if oldFields, ok := oldFields[message]; ok {
+			diff.AddedFields = append(diff.AddedFields, difference(fields, oldFields)...)
+			diff.RemovedFields = append(diff.RemovedFields, difference(oldFields, fields)...)
+		}
+	}
+	sort.Strings(diff.AddedFields)
+	sort.Strings(diff.RemovedFields)
+	return diff
+}
+
+// Empty reports whether nothing changed.
+func (diff *Diff) Empty() bool {
+	return len(diff.AddedRPCs)+len(diff.RemovedRPCs)+len(diff.AddedMessages)+len(diff.RemovedMessages)+
+		len(diff.AddedFields)+len(diff.RemovedFields) == 0
+}
+
+// String returns the changes one per line, prefixed by + or -.
+func (diff *Diff) String() string {
+	var builder strings.Builder
+	write := func(sign, kind string, items []string) {
+		for _, item := range items {
+			fmt.Fprintf(&builder, "%s %s %s\n", sign, kind, item)
+		}
+	}
+	write("+", "rpc", diff.AddedRPCs)
+	write("-", "rpc", diff.RemovedRPCs)
+	write("+", "message", diff.AddedMessages)
+	write("-", "message", diff.RemovedMessages)
+	write("+", "field", diff.AddedFields)
+	write("-", "field", diff.RemovedFields)
+	return builder.String()
+}
+
+// signatures returns the rpcs, the messages and the fields of each message of file.
+func (file *File) signatures() (rpcs, messages map[string]struct{}, fields map[string]map[string]struct{}) {
+	rpcs = make(map[string]struct{})
+	messages = make(map[string]struct{})
+	fields = make(map[string]map[string]struct{})
+	var walk func(scope string, definitions []Definition)
+	walk = func(scope string, definitions []Definition) {
+		for _, definition := range definitions {
+			fullName := definition.DefinitionName()
+			if len(scope) != 0 {
+				fullName = fmt.Sprintf("%s.%s", scope, fullName)
+			}
+			switch definition := definition.(type) {
+			case *Service:
+				for _, method := range definition.Methods {
+					rpcs[fmt.Sprintf("%s.%s (%s) returns (%s)", fullName, method.Name, method.RequestType, method.ResponseType)] = struct{}{}
+				}
+			case *Message:
+				messages[fullName] = struct{}{}
+				set := make(map[string]struct{}, len(definition.Fields))
+				for _, field := range definition.Fields {
+					rule := ""
+					if len(field.Rule) != 0 {
+						rule = field.Rule + " "
+					}
+					set[fmt.Sprintf("%s.%s %s%s = %d", fullName, field.ProtoName(), rule, field.Type, field.ID)] = struct{}{}
+				}
+				fields[fullName] = set
+				walk(fullName, definition.Nested)
+			}
+		}
+	}
+	walk(file.Package, file.Definitions)
+	return rpcs, messages, fields
+}
+
+// difference returns the sorted items of a missing from b.
+func difference(a, b map[string]struct{}) []string {
+	var items []string
+	for item := range a {
+		if _, ok := b[item]; !ok {
+			items = append(items, item)
+		}
+	}
+	sort.Strings(items)
+	return items
+}
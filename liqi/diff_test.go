@@ -0,0 +1,94 @@
+package liqi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	const old = `{"nested":{"lq":{"nested":{
+		"Lobby":{"methods":{"login":{"requestType":"ReqLogin","responseType":"ResLogin"},"logout":{"requestType":"ReqLogout","responseType":"ResLogout"}}},
+		"ReqLogin":{"fields":{"account":{"type":"string","id":1},"tag":{"type":"string","id":7}},
+			"nested":{"Device":{"fields":{"os":{"type":"string","id":1}}}}},
+		"ReqLogout":{"fields":{}}
+	}}}}`
+	tests := []struct {
+		name string
+		json string
+		want *Diff
+	}{
+		{
+			name: "same definitions",
+			json: old,
+			want: &Diff{},
+		},
+		{
+			name: "rpc and message added and removed",
+			json: `{"nested":{"lq":{"nested":{
+				"Lobby":{"methods":{"login":{"requestType":"ReqLogin","responseType":"ResLogin"},"fetchInfo":{"requestType":"ReqCommon","responseType":"ResFetchInfo"}}},
+				"ReqLogin":{"fields":{"account":{"type":"string","id":1},"tag":{"type":"string","id":7}},
+					"nested":{"Device":{"fields":{"os":{"type":"string","id":1}}}}},
+				"NotifyGamePause":{"fields":{"paused":{"type":"bool","id":1}}}
+			}}}}`,
+			want: &Diff{
+				AddedRPCs:       []string{"lq.Lobby.fetchInfo (ReqCommon) returns (ResFetchInfo)"},
+				RemovedRPCs:     []string{"lq.Lobby.logout (ReqLogout) returns (ResLogout)"},
+				AddedMessages:   []string{"lq.NotifyGamePause"},
+				RemovedMessages: []string{"lq.ReqLogout"},
+			},
+		},
+		{
+			name: "fields added, removed and changed",
+			json: `{"nested":{"lq":{"nested":{
+				"Lobby":{"methods":{"login":{"requestType":"ReqLogin","responseType":"ResLogin"},"logout":{"requestType":"ReqLogout","responseType":"ResLogout"}}},
+				"ReqLogin":{"fields":{"account":{"type":"string","id":1},"tag":{"rule":"repeated","type":"string","id":7},"currencyPlatforms":{"rule":"repeated","type":"uint32","id":8}},
+					"nested":{"Device":{"fields":{"os":{"type":"string","id":2}}}}},
+				"ReqLogout":{"fields":{}}
+			}}}}`,
+			want: &Diff{
+				AddedFields: []string{
+					"lq.ReqLogin.Device.os string = 2",
+					"lq.ReqLogin.currency_platforms repeated uint32 = 8",
+					"lq.ReqLogin.tag repeated string = 7",
+				},
+				RemovedFields: []string{
+					"lq.ReqLogin.Device.os string = 1",
+					"lq.ReqLogin.tag string = 7",
+				},
+			},
+		},
+	}
+	previous, err := Parse([]byte(old))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := Parse([]byte(test.json))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			diff := file.Compare(previous)
+			if !reflect.DeepEqual(diff, test.want) {
+				t.Errorf("Compare = %#v, want %#v", diff, test.want)
+			}
+			if empty := reflect.DeepEqual(test.want, &Diff{}); diff.Empty() != empty {
+				t.Errorf("Empty = %v, want %v", diff.Empty(), empty)
+			}
+		})
+	}
+}
+
+func TestDiffString(t *testing.T) {
+	diff := &Diff{
+		AddedRPCs:     []string{"lq.Lobby.fetchInfo (ReqCommon) returns (ResFetchInfo)"},
+		RemovedFields: []string{"lq.ReqLogin.tag string = 7"},
+	}
+	want := "+ rpc lq.Lobby.fetchInfo (ReqCommon) returns (ResFetchInfo)\n- field lq.ReqLogin.tag string = 7\n"
+	if got := diff.String(); got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+	if got := (&Diff{}).String(); got != "" {
+		t.Errorf("String of an empty diff = %q", got)
+	}
+}
@@ -0,0 +1,219 @@
+package liqi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"os/exec"
+	"strings"
+)
+
+const (
+	contextPackage = protogen.GoImportPath("context")
+	grpcPackage    = protogen.GoImportPath("google.golang.org/grpc")
+	codesPackage   = protogen.GoImportPath("google.golang.org/grpc/codes")
+	statusPackage  = protogen.GoImportPath("google.golang.org/grpc/status")
+)
+
+// generateRequest returns the request protoc would send to a plugin generating fd into the Go package importPath.
+func generateRequest(fd *descriptorpb.FileDescriptorProto, importPath string) *pluginpb.CodeGeneratorRequest {
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fd.GetName()},
+		Parameter:      proto.String(fmt.Sprintf("M%s=%s", fd.GetName(), importPath)),
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{fd},
+	}
+}
+
+// generatedFile returns the content of the single file of response.
+func generatedFile(response *pluginpb.CodeGeneratorResponse) ([]byte, error) {
+	if response.Error != nil {
+		return nil, errors.New(response.GetError())
+	}
+	if len(response.File) != 1 {
+		return nil, errors.New("no file generated")
+	}
+	return []byte(response.File[0].GetContent()), nil
+}
+
+// GenerateGo returns the Go code of the messages and enums of fd, liqi.pb.go, generated by running plugin as protoc
+// would, plugin is the command line of protoc-gen-go, e.g. "go run google.golang.org/protobuf/cmd/protoc-gen-go".
+// importPath is the Go package of the code, optionally followed by ";name".
+// The json tags have no omitempty, so that encoded messages show every field.
+func GenerateGo(ctx context.Context, fd *descriptorpb.FileDescriptorProto, importPath string, plugin []string) ([]byte, error) {
+	if len(plugin) == 0 {
+		return nil, errors.New("generate go error: no protoc-gen-go command")
+	}
+	request, err := proto.Marshal(generateRequest(fd, importPath))
+	if err != nil {
+		return nil, fmt.Errorf("generate go error: %w", err)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, plugin[0], plugin[1:]...)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("generate go error: %s: %w: %s", strings.Join(plugin, " "), err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	response := new(pluginpb.CodeGeneratorResponse)
+	if err = proto.Unmarshal(stdout.Bytes(), response); err != nil {
+		return nil, fmt.Errorf("generate go error: %s: %w", strings.Join(plugin, " "), err)
+	}
+	content, err := generatedFile(response)
+	if err != nil {
+		return nil, fmt.Errorf("generate go error: %w", err)
+	}
+	return bytes.ReplaceAll(content, []byte(",omitempty\"`"), []byte("\"`")), nil
+}
+
+// GenerateGrpc returns the grpc clients and servers of the services of fd, liqi_grpc.pb.go, laid out like the former
+// grpc plugin of protoc-gen-go. importPath is the Go package of the code, optionally followed by ";name".
+func GenerateGrpc(fd *descriptorpb.FileDescriptorProto, importPath string) ([]byte, error) {
+	plugin, err := protogen.Options{}.New(generateRequest(fd, importPath))
+	if err != nil {
+		return nil, fmt.Errorf("generate grpc error: %w", err)
+	}
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+		g := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+"_grpc.pb.go", file.GoImportPath)
+		g.P("// Code generated by liqigen. DO NOT EDIT.")
+		g.P("// source: ", file.Desc.Path())
+		g.P()
+		g.P("package ", file.GoPackageName)
+		g.P()
+		generateServices(g, file)
+	}
+	content, err := generatedFile(plugin.Response())
+	if err != nil {
+		return nil, fmt.Errorf("generate grpc error: %w", err)
+	}
+	return content, nil
+}
+
+// generateServices writes the grpc code of the services of file, laid out like the former grpc plugin of protoc-gen-go.
+func generateServices(g *protogen.GeneratedFile, file *protogen.File) {
+	if len(file.Services) == 0 {
+		return
+	}
+	g.P("// Reference imports to suppress errors if they are not otherwise used.")
+	g.P("var _ ", contextPackage.Ident("Context"))
+	g.P("var _ ", grpcPackage.Ident("ClientConnInterface"))
+	g.P()
+	g.P("// This is a compile-time assertion to ensure that this generated file")
+	g.P("// is compatible with the grpc package it is being compiled against.")
+	g.P("const _ = ", grpcPackage.Ident("SupportPackageIsVersion6"))
+	g.P()
+	for _, service := range file.Services {
+		generateService(g, file, service)
+	}
+}
+
+func generateService(g *protogen.GeneratedFile, file *protogen.File, service *protogen.Service) {
+	serviceName := service.GoName
+	clientName := serviceName + "Client"
+	clientType := unexport(clientName)
+	serverName := serviceName + "Server"
+	fullName := string(service.Desc.FullName())
+	descName := fmt.Sprintf("_%s_serviceDesc", serviceName)
+
+	g.P("// ", clientName, " is the client API for ", serviceName, " service.")
+	g.P("//")
+	g.P("// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.")
+	g.P("type ", clientName, " interface {")
+	for _, method := range service.Methods {
+		g.P(method.GoName, "(ctx ", contextPackage.Ident("Context"), ", in *", method.Input.GoIdent, ", opts ...", grpcPackage.Ident("CallOption"), ") (*", method.Output.GoIdent, ", error)")
+	}
+	g.P("}")
+	g.P()
+	g.P("type ", clientType, " struct {")
+	g.P("cc ", grpcPackage.Ident("ClientConnInterface"))
+	g.P("}")
+	g.P()
+	g.P("func New", clientName, "(cc ", grpcPackage.Ident("ClientConnInterface"), ") ", clientName, " {")
+	g.P("return &", clientType, "{cc}")
+	g.P("}")
+	g.P()
+	for _, method := range service.Methods {
+		g.P("func (c *", clientType, ") ", method.GoName, "(ctx ", contextPackage.Ident("Context"), ", in *", method.Input.GoIdent, ", opts ...", grpcPackage.Ident("CallOption"), ") (*", method.Output.GoIdent, ", error) {")
+		g.P("out := new(", method.Output.GoIdent, ")")
+		g.P("err := c.cc.Invoke(ctx, \"/", fullName, "/", method.Desc.Name(), "\", in, out, opts...)")
+		g.P("if err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("return out, nil")
+		g.P("}")
+		g.P()
+	}
+
+	g.P("// ", serverName, " is the server API for ", serviceName, " service.")
+	g.P("type ", serverName, " interface {")
+	for _, method := range service.Methods {
+		g.P(method.GoName, "(", contextPackage.Ident("Context"), ", *", method.Input.GoIdent, ") (*", method.Output.GoIdent, ", error)")
+	}
+	g.P("}")
+	g.P()
+	g.P("// Unimplemented", serverName, " can be embedded to have forward compatible implementations.")
+	g.P("type Unimplemented", serverName, " struct {")
+	g.P("}")
+	g.P()
+	for _, method := range service.Methods {
+		g.P("func (*Unimplemented", serverName, ") ", method.GoName, "(", contextPackage.Ident("Context"), ", *", method.Input.GoIdent, ") (*", method.Output.GoIdent, ", error) {")
+		g.P("return nil, ", statusPackage.Ident("Errorf"), "(", codesPackage.Ident("Unimplemented"), ", \"method ", method.GoName, " not implemented\")")
+		g.P("}")
+	}
+	g.P()
+	g.P("func Register", serverName, "(s *", grpcPackage.Ident("Server"), ", srv ", serverName, ") {")
+	g.P("s.RegisterService(&", descName, ", srv)")
+	g.P("}")
+	g.P()
+	for _, method := range service.Methods {
+		handlerName := fmt.Sprintf("_%s_%s_Handler", serviceName, method.GoName)
+		g.P("func ", handlerName, "(srv interface{}, ctx ", contextPackage.Ident("Context"), ", dec func(interface{}) error, interceptor ", grpcPackage.Ident("UnaryServerInterceptor"), ") (interface{}, error) {")
+		g.P("in := new(", method.Input.GoIdent, ")")
+		g.P("if err := dec(in); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("if interceptor == nil {")
+		g.P("return srv.(", serverName, ").", method.GoName, "(ctx, in)")
+		g.P("}")
+		g.P("info := &", grpcPackage.Ident("UnaryServerInfo"), "{")
+		g.P("Server: srv,")
+		g.P("FullMethod: \"/", fullName, "/", method.GoName, "\",")
+		g.P("}")
+		g.P("handler := func(ctx ", contextPackage.Ident("Context"), ", req interface{}) (interface{}, error) {")
+		g.P("return srv.(", serverName, ").", method.GoName, "(ctx, req.(*", method.Input.GoIdent, "))")
+		g.P("}")
+		g.P("return interceptor(ctx, in, info, handler)")
+		g.P("}")
+		g.P()
+	}
+	g.P("var ", descName, " = ", grpcPackage.Ident("ServiceDesc"), "{")
+	g.P("ServiceName: \"", fullName, "\",")
+	g.P("HandlerType: (*", serverName, ")(nil),")
+	g.P("Methods: []", grpcPackage.Ident("MethodDesc"), "{")
+	for _, method := range service.Methods {
+		g.P("{")
+		g.P("MethodName: \"", method.Desc.Name(), "\",")
+		g.P("Handler: _", serviceName, "_", method.GoName, "_Handler,")
+		g.P("},")
+	}
+	g.P("},")
+	g.P("Streams: []", grpcPackage.Ident("StreamDesc"), "{},")
+	g.P("Metadata: \"", file.Desc.Path(), "\",")
+	g.P("}")
+	g.P()
+}
+
+func unexport(name string) string {
+	if len(name) == 0 {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
@@ -0,0 +1,316 @@
+// Package liqi converts liqi.json, the protobufjs JSON descriptor of the protocol used by the Majsoul client,
+// to .proto source, protobuf descriptors and Go code, and compares two versions of it.
+package liqi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// File is a parsed liqi.json, definitions are kept in declaration order.
+type File struct {
+	Package     string       // Package of the definitions, e.g. "lq"
+	Definitions []Definition // *Message, *Enum or *Service
+}
+
+// Definition is a *Message, *Enum or *Service.
+type Definition interface {
+	DefinitionName() string
+}
+
+// Message is a message type.
+type Message struct {
+	Name   string
+	Fields []*Field
+	Nested []Definition // Nested *Message and *Enum
+}
+
+// Field is a field of a message.
+type Field struct {
+	Name string
+	Type string // Type as written in liqi.json, e.g. "uint32", "Error" or "lq.RewardSlot"
+	ID   int32
+	Rule string // "repeated" or empty
+}
+
+// ProtoName returns the name of the field in .proto source, camel case names are converted like pbjs does:
+// "dealPrice" becomes "deal_price".
+func (field *Field) ProtoName() string {
+	var builder strings.Builder
+	runes := []rune(field.Name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (i+1 == len(runes) || unicode.IsLower(runes[i+1])) {
+			builder.WriteRune('_')
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// Enum is an enum type.
+type Enum struct {
+	Name   string
+	Values []*EnumValue
+}
+
+// EnumValue is a value of an enum.
+type EnumValue struct {
+	Name   string
+	Number int32
+}
+
+// Service is an rpc service.
+type Service struct {
+	Name    string
+	Methods []*Method
+}
+
+// Method is an rpc of a service.
+type Method struct {
+	Name         string
+	RequestType  string
+	ResponseType string
+}
+
+func (message *Message) DefinitionName() string { return message.Name }
+func (enum *Enum) DefinitionName() string       { return enum.Name }
+func (service *Service) DefinitionName() string { return service.Name }
+
+// object is a JSON object whose keys are kept in order.
+type object struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func decodeObject(data []byte) (*object, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("want object, got %v", token)
+	}
+	obj := &object{
+		keys:   nil,
+		values: make(map[string]json.RawMessage),
+	}
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := token.(string)
+		var value json.RawMessage
+		if err = decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		if _, ok := obj.values[key]; !ok {
+			obj.keys = append(obj.keys, key)
+		}
+		obj.values[key] = value
+	}
+	if _, err = decoder.Token(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// ParseFile parses the liqi.json at path.
+func ParseFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse parses a protobufjs JSON descriptor such as liqi.json.
+// Namespaces holding only a namespace are folded into the package, like pbjs does.
+func Parse(data []byte) (*File, error) {
+	root, err := decodeObject(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse liqi.json error: %w", err)
+	}
+	var pkg []string
+	for {
+		nested, err := nestedOf(root)
+		if err != nil {
+			return nil, fmt.Errorf("parse liqi.json error: %w", err)
+		}
+		if len(nested.keys) != 1 {
+			break
+		}
+		child, err := decodeObject(nested.values[nested.keys[0]])
+		if err != nil {
+			return nil, fmt.Errorf("parse liqi.json error: %s: %w", nested.keys[0], err)
+		}
+		if kindOf(child) != "namespace" {
+			break
+		}
+		pkg = append(pkg, nested.keys[0])
+		root = child
+	}
+	definitions, err := parseNested(root, strings.Join(pkg, "."), true)
+	if err != nil {
+		return nil, fmt.Errorf("parse liqi.json error: %w", err)
+	}
+	return &File{
+		Package:     strings.Join(pkg, "."),
+		Definitions: definitions,
+	}, nil
+}
+
+// nestedOf returns the nested object of obj, an empty object if there is none.
+func nestedOf(obj *object) (*object, error) {
+	raw, ok := obj.values["nested"]
+	if !ok {
+		return &object{keys: nil, values: nil}, nil
+	}
+	return decodeObject(raw)
+}
+
+// kindOf tells whether obj is a "message", "enum", "service" or "namespace".
+func kindOf(obj *object) string {
+	switch {
+	case obj.values["fields"] != nil:
+		return "message"
+	case obj.values["values"] != nil:
+		return "enum"
+	case obj.values["methods"] != nil:
+		return "service"
+	default:
+		return "namespace"
+	}
+}
+
+func parseNested(obj *object, scope string, topLevel bool) ([]Definition, error) {
+	nested, err := nestedOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	definitions := make([]Definition, 0, len(nested.keys))
+	for _, name := range nested.keys {
+		child, err := decodeObject(nested.values[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", scope, name, err)
+		}
+		var definition Definition
+		switch kind := kindOf(child); {
+		case kind == "message":
+			definition, err = parseMessage(name, child, scope)
+		case kind == "enum":
+			definition, err = parseEnum(name, child)
+		case kind == "service" && topLevel:
+			definition, err = parseService(name, child)
+		default:
+			err = fmt.Errorf("unsupported %s", kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", scope, name, err)
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+func parseMessage(name string, obj *object, scope string) (*Message, error) {
+	for _, key := range obj.keys {
+		if key != "fields" && key != "nested" {
+			return nil, fmt.Errorf("unsupported %q", key)
+		}
+	}
+	fields, err := decodeObject(obj.values["fields"])
+	if err != nil {
+		return nil, err
+	}
+	message := &Message{
+		Name:   name,
+		Fields: make([]*Field, 0, len(fields.keys)),
+		Nested: nil,
+	}
+	for _, fieldName := range fields.keys {
+		field := &struct {
+			Type    string          `json:"type"`
+			ID      int32           `json:"id"`
+			Rule    string          `json:"rule"`
+			KeyType string          `json:"keyType"`
+			Options json.RawMessage `json:"options"`
+		}{}
+		if err = json.Unmarshal(fields.values[fieldName], field); err != nil {
+			return nil, fmt.Errorf("field %s: %w", fieldName, err)
+		}
+		if len(field.KeyType) != 0 || len(field.Options) != 0 || (len(field.Rule) != 0 && field.Rule != "repeated") {
+			return nil, fmt.Errorf("field %s: unsupported map, options or rule %q", fieldName, field.Rule)
+		}
+		message.Fields = append(message.Fields, &Field{
+			Name: fieldName,
+			Type: field.Type,
+			ID:   field.ID,
+			Rule: field.Rule,
+		})
+	}
+	message.Nested, err = parseNested(obj, fmt.Sprintf("%s.%s", scope, name), false)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func parseEnum(name string, obj *object) (*Enum, error) {
+	values, err := decodeObject(obj.values["values"])
+	if err != nil {
+		return nil, err
+	}
+	enum := &Enum{
+		Name:   name,
+		Values: make([]*EnumValue, 0, len(values.keys)),
+	}
+	for _, valueName := range values.keys {
+		var number int32
+		if err = json.Unmarshal(values.values[valueName], &number); err != nil {
+			return nil, fmt.Errorf("value %s: %w", valueName, err)
+		}
+		enum.Values = append(enum.Values, &EnumValue{
+			Name:   valueName,
+			Number: number,
+		})
+	}
+	return enum, nil
+}
+
+func parseService(name string, obj *object) (*Service, error) {
+	methods, err := decodeObject(obj.values["methods"])
+	if err != nil {
+		return nil, err
+	}
+	service := &Service{
+		Name:    name,
+		Methods: make([]*Method, 0, len(methods.keys)),
+	}
+	for _, methodName := range methods.keys {
+		method := &struct {
+			RequestType    string `json:"requestType"`
+			ResponseType   string `json:"responseType"`
+			RequestStream  bool   `json:"requestStream"`
+			ResponseStream bool   `json:"responseStream"`
+		}{}
+		if err = json.Unmarshal(methods.values[methodName], method); err != nil {
+			return nil, fmt.Errorf("method %s: %w", methodName, err)
+		}
+		if method.RequestStream || method.ResponseStream {
+			return nil, fmt.Errorf("method %s: unsupported stream", methodName)
+		}
+		service.Methods = append(service.Methods, &Method{
+			Name:         methodName,
+			RequestType:  method.RequestType,
+			ResponseType: method.ResponseType,
+		})
+	}
+	return service, nil
+}
@@ -0,0 +1,118 @@
+package liqi
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want *File
+	}{
+		{
+			name: "namespaces folded into the package",
+			json: `{"nested":{"lq":{"nested":{"config":{"nested":{"Empty":{"fields":{}}}}}}}}`,
+			want: &File{
+				Package:     "lq.config",
+				Definitions: []Definition{&Message{Name: "Empty", Fields: []*Field{}, Nested: []Definition{}}},
+			},
+		},
+		{
+			name: "no package",
+			json: `{"nested":{"A":{"fields":{}},"B":{"fields":{}}}}`,
+			want: &File{
+				Package: "",
+				Definitions: []Definition{
+					&Message{Name: "A", Fields: []*Field{}, Nested: []Definition{}},
+					&Message{Name: "B", Fields: []*Field{}, Nested: []Definition{}},
+				},
+			},
+		},
+		{
+			name: "definitions and fields in declaration order",
+			json: `{"nested":{"lq":{"nested":{
+				"Lobby":{"methods":{"login":{"requestType":"ReqLogin","responseType":"ResLogin"},"heatbeat":{"requestType":"ReqHeatBeat","responseType":"ResCommon"}}},
+				"ReqLogin":{"fields":{"account":{"type":"string","id":1},"currencyPlatforms":{"rule":"repeated","type":"uint32","id":8}},
+					"nested":{"Kind":{"values":{"NONE":0,"EMAIL":1}}}},
+				"GamePlayerState":{"values":{"NULL":0,"AUTH":1}}
+			}}}}`,
+			want: &File{
+				Package: "lq",
+				Definitions: []Definition{
+					&Service{Name: "Lobby", Methods: []*Method{
+						{Name: "login", RequestType: "ReqLogin", ResponseType: "ResLogin"},
+						{Name: "heatbeat", RequestType: "ReqHeatBeat", ResponseType: "ResCommon"},
+					}},
+					&Message{
+						Name: "ReqLogin",
+						Fields: []*Field{
+							{Name: "account", Type: "string", ID: 1, Rule: ""},
+							{Name: "currencyPlatforms", Type: "uint32", ID: 8, Rule: "repeated"},
+						},
+						Nested: []Definition{&Enum{Name: "Kind", Values: []*EnumValue{{Name: "NONE", Number: 0}, {Name: "EMAIL", Number: 1}}}},
+					},
+					&Enum{Name: "GamePlayerState", Values: []*EnumValue{{Name: "NULL", Number: 0}, {Name: "AUTH", Number: 1}}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file, err := Parse([]byte(test.json))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !reflect.DeepEqual(file, test.want) {
+				t.Errorf("Parse = %s, want %s", file.Proto(), test.want.Proto())
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string // part of the error
+	}{
+		{"not an object", `[]`, "want object"},
+		{"invalid json", `{"nested":`, "parse liqi.json error"},
+		{"map field", `{"nested":{"A":{"fields":{"m":{"keyType":"string","type":"string","id":1}}}}}`, "field m: unsupported map"},
+		{"field options", `{"nested":{"A":{"fields":{"f":{"type":"uint32","id":1,"options":{"packed":false}}}}}}`, "field f: unsupported"},
+		{"required field", `{"nested":{"A":{"fields":{"f":{"rule":"required","type":"uint32","id":1}}}}}`, `rule "required"`},
+		{"message options", `{"nested":{"A":{"fields":{},"options":{}}}}`, `unsupported "options"`},
+		{"streaming rpc", `{"nested":{"S":{"methods":{"m":{"requestType":"A","responseType":"A","responseStream":true}}}}}`, "method m: unsupported stream"},
+		{"nested service", `{"nested":{"A":{"fields":{},"nested":{"S":{"methods":{}}}}}}`, ".A.S: unsupported service"},
+		{"invalid enum value", `{"nested":{"E":{"values":{"V":"one"}}}}`, "value V"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.json))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("Parse error = %v, want one containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestProtoName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"account", "account"},
+		{"account_id", "account_id"},
+		{"dealPrice", "deal_price"},
+		{"currencyPlatforms", "currency_platforms"},
+		{"accountID", "accountI_d"}, // like pbjs, a capital ending the name starts a word
+		{"HTTPServer", "HTTP_server"},
+	}
+	for _, test := range tests {
+		if got := (&Field{Name: test.name}).ProtoName(); got != test.want {
+			t.Errorf("ProtoName of %s = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
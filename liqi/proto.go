@@ -0,0 +1,89 @@
+package liqi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Proto returns the .proto source of file, laid out like pbjs -t proto so that regenerated files diff cleanly.
+func (file *File) Proto() []byte {
+	printer := &protoPrinter{
+		lines:  []string{`syntax = "proto3";`},
+		indent: 0,
+	}
+	if len(file.Package) != 0 {
+		printer.push("")
+		printer.push(fmt.Sprintf("package %s;", file.Package))
+	}
+	printer.definitions(file.Definitions)
+	return []byte(strings.Join(printer.lines, "\n"))
+}
+
+type protoPrinter struct {
+	lines  []string
+	indent int
+}
+
+func (printer *protoPrinter) push(line string) {
+	if len(line) == 0 {
+		printer.lines = append(printer.lines, "")
+		return
+	}
+	printer.lines = append(printer.lines, strings.Repeat("    ", printer.indent)+line)
+}
+
+func (printer *protoPrinter) definitions(definitions []Definition) {
+	for _, definition := range definitions {
+		switch definition := definition.(type) {
+		case *Message:
+			printer.message(definition)
+		case *Enum:
+			printer.enum(definition)
+		case *Service:
+			printer.service(definition)
+		}
+	}
+}
+
+func (printer *protoPrinter) message(message *Message) {
+	printer.push("")
+	printer.push(fmt.Sprintf("message %s {", message.Name))
+	printer.indent++
+	if len(message.Fields) != 0 {
+		printer.push("")
+		for _, field := range message.Fields {
+			rule := ""
+			if len(field.Rule) != 0 {
+				rule = field.Rule + " "
+			}
+			printer.push(fmt.Sprintf("%s%s %s = %d;", rule, field.Type, field.ProtoName(), field.ID))
+		}
+	}
+	printer.definitions(message.Nested)
+	printer.indent--
+	printer.push("}")
+}
+
+func (printer *protoPrinter) enum(enum *Enum) {
+	printer.push("")
+	printer.push(fmt.Sprintf("enum %s {", enum.Name))
+	printer.indent++
+	if len(enum.Values) != 0 {
+		printer.push("")
+		for _, value := range enum.Values {
+			printer.push(fmt.Sprintf("%s = %d;", value.Name, value.Number))
+		}
+	}
+	printer.indent--
+	printer.push("}")
+}
+
+func (printer *protoPrinter) service(service *Service) {
+	printer.push(fmt.Sprintf("service %s {", service.Name))
+	printer.indent++
+	for _, method := range service.Methods {
+		printer.push(fmt.Sprintf("rpc %s (%s) returns (%s);", method.Name, method.RequestType, method.ResponseType))
+	}
+	printer.indent--
+	printer.push("}")
+}
@@ -3,21 +3,17 @@ package majsoul
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/constellation39/majsoul/logger"
 	"github.com/constellation39/majsoul/message"
 	"github.com/constellation39/majsoul/network"
 	"github.com/constellation39/majsoul/utils"
 	"go.uber.org/zap"
-	"google.golang.org/protobuf/proto"
-	"math/rand"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"net/http"
-	"net/http/cookiejar"
-	"net/url"
-	"nhooyr.io/websocket"
-	"reflect"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -42,331 +38,378 @@ var ServerAddressList = []*ServerAddress{
 	},
 }
 
+// defaultEventBuffer is the buffer used when Config.EventBuffer is not set.
+const defaultEventBuffer = 64
+
 // Config the configuration for Majsoul.
 type Config struct {
-	ProxyAddress string
+	ProxyAddress   string        // Proxy of every connection, http://, https:// or socks5:// with an optional user:password@
+	ProxyAddresses []string      // Proxy pool, each MajSoul created with the Config takes the next one, unused if ProxyAddress is set
+	Dialer         ContextDialer // Dials every connection, or the proxy, nil uses a net.Dialer
+
+	EventBuffer   int                    // Buffer of notify queues and Events streams, defaults to 64
+	EventOverflow network.OverflowPolicy // What to do when a notify queue or Events stream is full
+
+	UnaryInterceptors []grpc.UnaryClientInterceptor // Interceptors for every LobbyClient and FastTestClient call
+
+	ReconnectPolicy *network.ReconnectPolicy // How connections are reestablished, nil uses network.DefaultReconnectPolicy
+	Context         context.Context          // Once done connections stop reconnecting, nil never stops
+
+	KeepaliveInterval time.Duration // Interval of heartbeats and websocket pings, 0 uses 30 seconds, negative disables them and drops connections idle for a minute
+	VersionInterval   time.Duration // Interval of version.json polling, 0 uses 10 minutes, negative disables it
+
+	DisableSessionRecovery  bool // Do not log in and resynchronize the game automatically after a reconnect
+	DisableGatewayDiscovery bool // Only probe the servers given to LookupGateway, without reading the gateways they publish
+
+	Registry *Registry // Decodes notifies and actions unknown to the message package, see SetRegistry
+
+	UserAgent         string            // User-Agent of HTTP requests and websocket handshakes, defaults to network.UserAgent
+	AcceptLanguage    string            // Accept-Language of HTTP requests and websocket handshakes, defaults to Chinese
+	LoginTag          string            // Tag sent by Login, defaults to the one of the server profile
+	CurrencyPlatforms []uint32          // Currency platforms sent on login, defaults to the ones of the server profile
+	DeviceProfile     *DeviceProfile    // Device reported on login, nil uses NewDeviceProfile
+	DialTimeout       time.Duration     // Timeout of websocket handshakes, 0 uses 5 seconds
+	RequestTimeout    time.Duration     // Timeout of HTTP requests, 0 uses 5 seconds
+	HTTPClient        *http.Client      // Client of HTTP requests, nil builds one from the proxy, Dialer and RequestTimeout
+	RoundTripper      http.RoundTripper // Transport of HTTP requests and websocket handshakes, nil builds one from the proxy and Dialer
 }
 
 // MajSoul represents the main class for interacting with the Majsoul game server.
 type MajSoul struct {
-	config  *Config          // Config passed to the class
-	Request *network.Request // HTTP request sent to Majsoul
-	Version *Version         // Current version number
+	config       *Config          // Config passed to the class
+	proxyAddress string           // Proxy selected from the config, empty for none
+	Request      *network.Request // HTTP request sent to Majsoul
+	Version      *Version         // Current version number, see CurrentVersion
 
 	LobbyClient        message.LobbyClient    // LobbyClient is the interface for interacting with the Majsoul lobby
 	FastTestClient     message.FastTestClient // FastTestClient is the interface for interacting with the Majsoul game table
+	connMutex          sync.RWMutex           // Guards LobbyClient, FastTestClient and their connections when they are replaced
 	lobbyClientConn    *network.WsClient      // Connection used by LobbyClient
 	fastTestClientConn *network.WsClient      // Connection used by FastTestClient
 	ServerAddress      *ServerAddress         // Server address being used
+	Profile            *ServerProfile         // Profile of the server being used, nil if the server is not registered
 	UUID               string                 // UUID
-
-	handleMap                  map[string]*subscribe // Map of registered addresses
-	onGatewayReconnectCallBack func()                // Callback for gateway server reconnection
-	onGameReconnectCallBack    func()                // Callback for game server reconnection
-}
-
-// Subscribe subscribed message.
-type subscribe struct {
-	in   reflect.Type
-	call reflect.Value
+	Device             *DeviceProfile         // Device reported on login
+
+	handleMutex sync.RWMutex                           // Guards handleMap, streams, middlewares, handler, registry and onHandlerErrorCallBack
+	handleMap   map[protoreflect.FullName][]*subscribe // Handlers keyed by full message name
+	streams     []*eventStream                         // Streams opened by Events
+	middlewares []Middleware                           // Middlewares added by Use
+	handler     Handler                                // handle wrapped by middlewares
+	registry    *Registry                              // Types of the messages unknown to the message package
+
+	keepalive keepalive      // Heartbeat scheduler started by Login
+	watcher   versionWatcher // Version poller started by LookupGateway
+	session   session        // Login state used to restore connections
+	lifecycle lifecycle      // Connection state and shutdown
+	clients   clients        // Transport and cookie jar shared by every connection
+
+	onGatewayReconnectCallBack func()                                                  // Callback for gateway server reconnection
+	onGameReconnectCallBack    func()                                                  // Callback for game server reconnection
+	onReconnectAttemptCallBack func(source Source, attempt int, err error)             // Callback for failed reconnect attempts
+	onReconnectFailedCallBack  func(source Source, err error)                          // Callback for connections that stopped reconnecting
+	onHandlerErrorCallBack     func(name string, err error)                            // Callback for failed handlers
+	onLobbyRestoredCallBack    func(resLogin *message.ResLogin, err error)             // Callback for the login restored after a reconnect
+	onGameRestoredCallBack     func(*message.ResAuthGame, *message.GameRestore, error) // Callback for the game restored after a reconnect
+	onVersionChangedCallBack   func(changed VersionChanged)                            // Callback for a new version found by the version watcher
 }
 
 // NewMajSoul creates a new instance of MajSoul with the given configuration.
-func NewMajSoul(config *Config) *MajSoul {
+// Options are applied to a copy of config, which may be nil.
+func NewMajSoul(config *Config, options ...Option) *MajSoul {
+	if config == nil {
+		config = &Config{}
+	}
+	copied := *config
+	config = &copied
+	for _, option := range options {
+		option(config)
+	}
+	device := config.DeviceProfile
+	if device == nil {
+		device = NewDeviceProfile()
+	}
 	majSoul := &MajSoul{
 		config:                     config,
+		proxyAddress:               config.selectProxy(),
 		Request:                    nil,
 		Version:                    nil,
 		LobbyClient:                nil,
 		FastTestClient:             nil,
+		connMutex:                  sync.RWMutex{},
 		lobbyClientConn:            nil,
 		fastTestClientConn:         nil,
 		ServerAddress:              nil,
+		Profile:                    nil,
 		UUID:                       utils.UUID(),
-		handleMap:                  make(map[string]*subscribe),
+		Device:                     device,
+		handleMap:                  make(map[protoreflect.FullName][]*subscribe),
+		streams:                    nil,
+		middlewares:                nil,
+		handler:                    nil,
+		registry:                   config.Registry,
+		keepalive:                  keepalive{},
+		watcher:                    versionWatcher{},
+		session:                    session{},
+		lifecycle:                  newLifecycle(),
+		clients:                    clients{},
 		onGatewayReconnectCallBack: nil,
 		onGameReconnectCallBack:    nil,
+		onReconnectAttemptCallBack: nil,
+		onReconnectFailedCallBack:  nil,
+		onHandlerErrorCallBack:     nil,
+		onLobbyRestoredCallBack:    nil,
+		onGameRestoredCallBack:     nil,
+		onVersionChangedCallBack:   nil,
 	}
-	// 特殊注册的消息
-	majSoul.Handle(majSoul.ActionPrototype)
+	majSoul.trackSession()
 	return majSoul
 }
 
-// Handle registers callbacks for handling specific actions.
-// The callback should be a function with the following signature: func(*MajSoul, proto.Message).
-func (majSoul *MajSoul) Handle(callbacks ...interface{}) {
-	majSoul.register(&majSoul.handleMap, callbacks...)
-}
-
-func (majSoul *MajSoul) register(mapSubscribe *map[string]*subscribe, callbacks ...interface{}) {
-	for index, callback := range callbacks {
-		valueOf := reflect.ValueOf(callback)
-		if valueOf.IsNil() {
-			panic(fmt.Sprintf("index.%d callback is nil", index))
-		}
-		if valueOf.Type().NumIn() != 2 {
-			panic(fmt.Sprintf("index.%d callback input parameter length not 2 want (*MajSoul,proto.message as majsoul) length = %d", index, valueOf.Type().NumIn()))
-		}
-		inType0 := valueOf.Type().In(0)
-		if inType0.Kind() != reflect.Pointer {
-			panic(fmt.Sprintf("index.%d callback input parameter type not Pointer kind = %d", index, inType0.Kind()))
-		}
-		if inType0.Elem().Name() != "MajSoul" {
-			panic(fmt.Sprintf("index.%d callback input parameter 0 type not *MajSoul name = %s", index, inType0.Elem().Name()))
-		}
-		inType1 := valueOf.Type().In(1)
-		if inType1.Kind() != reflect.Pointer {
-			panic(fmt.Sprintf("index.%d callback input parameter type not Pointer kind = %d", index, inType1.Kind()))
-		}
-		name := inType1.Elem().Name()
-		(*mapSubscribe)[name] = &subscribe{
-			in:   inType1.Elem(),
-			call: valueOf,
-		}
-	}
-}
-
 // LookupGateway looks up the gateway server and establishes a connection.
+// The gateways published in the config of each server are probed in parallel together with the given ones,
+// see Config.DisableGatewayDiscovery, and the one with the lowest latency is kept.
+// When no candidate is usable the error is a *LookupError giving the reason of each one.
+// A previous lobby connection is closed first.
 func (majSoul *MajSoul) LookupGateway(ctx context.Context, serverAddressList []*ServerAddress) (err error) {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	for _, serverAddress := range serverAddressList {
-		var connUrl *url.URL
-		connUrl, err = url.Parse(serverAddress.ServerAddress)
-
-		if err != nil {
-			return fmt.Errorf("parse url error %v", err)
-		}
+	if majSoul.isClosing() {
+		return ErrClosed
+	}
+	if conn := majSoul.lobbyConn(); conn != nil {
+		_ = conn.Close()
+	}
+	majSoul.session.mutex.Lock()
+	majSoul.session.authenticated = false
+	majSoul.session.mutex.Unlock()
+	majSoul.setState(StateConnecting, nil)
+	defer func() {
+		majSoul.refreshState(err)
+	}()
+
+	candidates := majSoul.gatewayCandidates(ctx, serverAddressList)
+	probes := make([]*gatewayProbe, len(candidates))
+	var wg sync.WaitGroup
+	for i, candidate := range candidates {
+		wg.Add(1)
+		go func(i int, candidate *GatewayCandidate) {
+			defer wg.Done()
+			probes[i] = majSoul.probeGateway(ctx, candidate)
+		}(i, candidate)
+	}
+	wg.Wait()
 
-		header := http.Header{}
-		header.Add("Accept-Encoding", "gzip, deflate, br")
-		header.Add("Accept-Language", "zh-CN,zh;q=0.9,ja;q=0.8,en;q=0.7,en-GB;q=0.6,en-US;q=0.5")
-		header.Add("Cache-Control", "no-cache")
-		header.Add("Host", connUrl.Host)
-		header.Add("Origin", serverAddress.ServerAddress)
-		header.Add("Pragma", "no-cache")
-		header.Add("User-Agent", network.UserAgent)
-
-		{ // HTTP
-			httpClient := http.Client{
-				Jar: func() http.CookieJar {
-					jar, err := cookiejar.New(nil)
-					if err != nil {
-						panic(err)
-					}
-					return jar
-				}(),
-				Transport: func() http.RoundTripper {
-					if len(majSoul.config.ProxyAddress) == 0 {
-						return nil
-					}
-					proxy := func(_ *http.Request) (*url.URL, error) {
-						return url.Parse(majSoul.config.ProxyAddress)
-					}
-					return &http.Transport{Proxy: proxy}
-				}(),
-				Timeout: time.Second * 5,
-			}
-			majSoul.Request = network.NewRequest(serverAddress.ServerAddress, header, httpClient)
-			err = majSoul.getVersion()
-			if err != nil {
-				continue
-			}
+	var best *gatewayProbe
+	for _, probe := range probes {
+		if probe.conn != nil && (best == nil || probe.candidate.Latency < best.candidate.Latency) {
+			best = probe
 		}
-
-		{ // Websocket
-			httpClient := http.Client{
-				Jar: func() http.CookieJar {
-					jar, err := cookiejar.New(nil)
-					if err != nil {
-						panic(err)
-					}
-					return jar
-				}(),
-				Transport: func() http.RoundTripper {
-					if len(majSoul.config.ProxyAddress) == 0 {
-						return nil
-					}
-					proxy := func(_ *http.Request) (*url.URL, error) {
-						return url.Parse(majSoul.config.ProxyAddress)
-					}
-					return &http.Transport{Proxy: proxy}
-				}(),
-				Timeout: time.Second * 5,
-			}
-			majSoul.lobbyClientConn = network.NewWsClient(serverAddress.GatewayAddress, websocket.DialOptions{
-				HTTPClient:           &httpClient,
-				HTTPHeader:           header,
-				Subprotocols:         nil,
-				CompressionMode:      0,
-				CompressionThreshold: 0,
-			})
-			err = majSoul.lobbyClientConn.Connect(ctx)
-			if err != nil {
-				continue
-			}
-			majSoul.ServerAddress = serverAddress
-			majSoul.LobbyClient = message.NewLobbyClient(majSoul.lobbyClientConn)
+	}
+	for _, probe := range probes {
+		if probe != best && probe.conn != nil {
+			go probe.conn.Close()
 		}
-
-		go majSoul.readLobbyClientConn()
-
-		return nil
 	}
+	if best == nil {
+		majSoul.Request = nil
+		return &LookupError{Candidates: candidates}
+	}
+	logger.Debug("majSoul gateway selected", zap.String("gateway", best.candidate.Address.GatewayAddress), zap.Duration("latency", best.candidate.Latency))
 
-	majSoul.Request = nil
-	return fmt.Errorf("no server")
-}
-
-// Version represents the version information for the client.
-type Version struct {
-	Version      string `json:"version"`
-	ForceVersion string `json:"force_version"`
-	Code         string `json:"code"`
-}
-
-// Web return version web format
-// field Version "0.10.113.web"
-// return "web-0.10.113"
-func (v *Version) Web() string {
-	return fmt.Sprintf("web-%s", v.Version[:len(v.Version)-2])
-}
+	majSoul.Request = best.request
+	majSoul.setVersion(best.version)
+	majSoul.ServerAddress = best.candidate.Address
+	majSoul.Profile = best.candidate.Profile
 
-func (majSoul *MajSoul) version() (*Version, error) {
-	r := int(rand.Float32()*1e9) + int(rand.Float32()*1e9)
-	body, err := majSoul.Request.Get(fmt.Sprintf("1/version.json?randv=%d", r))
-	if err != nil {
-		return nil, err
+	if best.version.ProtoOutdated() {
+		logger.Warn("majSoul force version is newer than liqi.proto", zap.String("forceVersion", best.version.ForceVersion), zap.String("protoVersion", ProtoVersion))
 	}
-	version := new(Version)
-	err = json.Unmarshal(body, version)
-	if err != nil {
-		return nil, err
+	adopted := majSoul.adopt(func() {
+		majSoul.connMutex.Lock()
+		majSoul.lobbyClientConn = best.conn
+		majSoul.LobbyClient = message.NewLobbyClient(best.conn)
+		majSoul.connMutex.Unlock()
+		majSoul.startVersionWatcher(best.request)
+		majSoul.lifecycle.readers.Add(1)
+		go majSoul.readLobbyClientConn(best.conn)
+	})
+	if !adopted {
+		_ = best.conn.Close()
+		return ErrClosed
 	}
-	return version, nil
-}
-
-func (majSoul *MajSoul) getVersion() (err error) {
-	majSoul.Version, err = majSoul.version()
-	return
+	return nil
 }
 
 // ConnGame connects to the game server.
+// A previous game connection is closed first.
 func (majSoul *MajSoul) ConnGame(ctx context.Context) (err error) {
-	var connUrl *url.URL
-	connUrl, err = url.Parse(majSoul.ServerAddress.GameAddress)
+	if majSoul.isClosing() {
+		return ErrClosed
+	}
+	if conn := majSoul.gameConn(); conn != nil {
+		_ = conn.Close()
+	}
+	defer func() {
+		majSoul.refreshState(err)
+	}()
 
+	conn, err := majSoul.newWsClient(majSoul.ServerAddress.GameAddress, majSoul.ServerAddress.GameAddress)
 	if err != nil {
-		return fmt.Errorf("parse url error %v", err)
-	}
-
-	header := http.Header{}
-	header.Add("Accept-Encoding", "gzip, deflate, br")
-	header.Add("Accept-Language", "zh-CN,zh;q=0.9,ja;q=0.8,en;q=0.7,en-GB;q=0.6,en-US;q=0.5")
-	header.Add("Cache-Control", "no-cache")
-	header.Add("Host", connUrl.Host)
-	header.Add("Origin", majSoul.ServerAddress.GameAddress)
-	header.Add("Pragma", "no-cache")
-	header.Add("User-Agent", network.UserAgent)
-	httpClient := http.Client{
-		Jar: func() http.CookieJar {
-			jar, err := cookiejar.New(nil)
-			if err != nil {
-				panic(err)
-			}
-			return jar
-		}(),
-		Transport: func() http.RoundTripper {
-			if len(majSoul.config.ProxyAddress) == 0 {
-				return nil
-			}
-			proxy := func(_ *http.Request) (*url.URL, error) {
-				return url.Parse(majSoul.config.ProxyAddress)
-			}
-			return &http.Transport{Proxy: proxy}
-		}(),
-		Timeout: time.Second * 5,
-	}
-
-	majSoul.fastTestClientConn = network.NewWsClient(majSoul.ServerAddress.GameAddress, websocket.DialOptions{
-		HTTPClient:           &httpClient,
-		HTTPHeader:           header,
-		Subprotocols:         nil,
-		CompressionMode:      0,
-		CompressionThreshold: 0,
-	})
-	err = majSoul.fastTestClientConn.Connect(ctx)
+		return err
+	}
+	majSoul.bindReconnectHandlers(SourceGame, conn)
+	err = conn.Connect(ctx)
 	if err != nil {
+		_ = conn.Close()
 		return fmt.Errorf("connect game server failed error %v", err)
 	}
-	majSoul.FastTestClient = message.NewFastTestClient(majSoul.fastTestClientConn)
-	go majSoul.readFastTestClientConn()
+	adopted := majSoul.adopt(func() {
+		majSoul.connMutex.Lock()
+		majSoul.fastTestClientConn = conn
+		majSoul.FastTestClient = message.NewFastTestClient(conn)
+		majSoul.connMutex.Unlock()
+		majSoul.lifecycle.readers.Add(1)
+		go majSoul.readFastTestClientConn(conn)
+	})
+	if !adopted {
+		_ = conn.Close()
+		return ErrClosed
+	}
 	return nil
 }
 
-func (majSoul *MajSoul) readLobbyClientConn() {
-	if majSoul.lobbyClientConn == nil {
-		panic("lobbyClient Conn is nil")
-	}
-	majSoul.lobbyClientConn.ReconnectHandler = majSoul.onGatewayReconnectCallBack
-	receive := majSoul.lobbyClientConn.Receive()
-	for wrapper := range receive {
-		majSoul.callHandleMap(wrapper)
-	}
+// lobbyClient returns LobbyClient, for the calls made by goroutines that may run next to LookupGateway.
+func (majSoul *MajSoul) lobbyClient() message.LobbyClient {
+	majSoul.connMutex.RLock()
+	defer majSoul.connMutex.RUnlock()
+	return majSoul.LobbyClient
+}
+
+// gameClient returns FastTestClient, for the calls made by goroutines that may run next to ConnGame.
+func (majSoul *MajSoul) gameClient() message.FastTestClient {
+	majSoul.connMutex.RLock()
+	defer majSoul.connMutex.RUnlock()
+	return majSoul.FastTestClient
 }
 
-func (majSoul *MajSoul) readFastTestClientConn() {
-	if majSoul.fastTestClientConn == nil {
-		panic("fastTestClient Conn is nil")
+// lobbyConn returns the connection used by LobbyClient, nil before LookupGateway.
+func (majSoul *MajSoul) lobbyConn() *network.WsClient {
+	majSoul.connMutex.RLock()
+	defer majSoul.connMutex.RUnlock()
+	return majSoul.lobbyClientConn
+}
+
+// gameConn returns the connection used by FastTestClient, nil before ConnGame.
+func (majSoul *MajSoul) gameConn() *network.WsClient {
+	majSoul.connMutex.RLock()
+	defer majSoul.connMutex.RUnlock()
+	return majSoul.fastTestClientConn
+}
+
+// wsOptions returns the options shared by the lobby and game connections.
+func (majSoul *MajSoul) wsOptions() []network.WsOption {
+	size := majSoul.config.EventBuffer
+	if size <= 0 {
+		size = defaultEventBuffer
+	}
+	options := []network.WsOption{
+		network.WithNotifyBuffer(size),
+		network.WithOverflowPolicy(majSoul.config.EventOverflow),
+		network.WithUnaryInterceptors(majSoul.versionInterceptor),
+		network.WithUnaryInterceptors(majSoul.config.UnaryInterceptors...),
+	}
+	if majSoul.config.ReconnectPolicy != nil {
+		options = append(options, network.WithReconnectPolicy(*majSoul.config.ReconnectPolicy))
 	}
-	majSoul.fastTestClientConn.ReconnectHandler = majSoul.onGameReconnectCallBack
-	receive := majSoul.fastTestClientConn.Receive()
-	for wrapper := range receive {
-		majSoul.callHandleMap(wrapper)
+	if majSoul.config.Context != nil {
+		options = append(options, network.WithContext(majSoul.config.Context))
 	}
+	if interval := majSoul.keepaliveInterval(); interval > 0 {
+		options = append(options, network.WithPingInterval(interval))
+	}
+	return options
 }
 
-func (majSoul *MajSoul) callHandleMap(wrapper *message.Wrapper) {
-	token := strings.Split(wrapper.Name, ".")
-	if len(token) == 0 {
-		panic(fmt.Sprintf("wrapper.Name == %s", wrapper.Name))
+// bindReconnectHandlers forwards the reconnect events of conn to the state and the callbacks registered on majSoul.
+func (majSoul *MajSoul) bindReconnectHandlers(source Source, conn *network.WsClient) {
+	switch source {
+	case SourceLobby:
+		conn.ReconnectHandler = majSoul.onLobbyReconnect
+	case SourceGame:
+		conn.ReconnectHandler = majSoul.onGameReconnect
 	}
-	name := token[len(token)-1]
-	ss, ok := majSoul.handleMap[name]
-	if !ok {
-		logger.Info("unregistered notify", zap.String("name", wrapper.Name))
-		return
+	conn.DisconnectHandler = func(err error) {
+		if source == SourceLobby {
+			majSoul.session.mutex.Lock()
+			majSoul.session.authenticated = false
+			majSoul.session.mutex.Unlock()
+		}
+		majSoul.refreshState(err)
 	}
-	inValue := reflect.New(ss.in)
-	notify, ok := inValue.Interface().(proto.Message)
-	if !ok {
-		panic("in type not implements proto.Message")
+	conn.ReconnectAttemptHandler = func(attempt int, err error) {
+		if majSoul.onReconnectAttemptCallBack != nil {
+			majSoul.onReconnectAttemptCallBack(source, attempt, err)
+		}
 	}
-	err := proto.Unmarshal(wrapper.Data, notify)
-	if err != nil {
-		panic(fmt.Sprintf("proto unmarshal error %v", err))
+	conn.ReconnectFailedHandler = func(err error) {
+		majSoul.refreshState(err)
+		if majSoul.onReconnectFailedCallBack != nil {
+			majSoul.onReconnectFailedCallBack(source, err)
+		}
+	}
+}
+
+// readLobbyClientConn passes the notifies of conn to the handlers until conn is closed.
+func (majSoul *MajSoul) readLobbyClientConn(conn *network.WsClient) {
+	defer majSoul.lifecycle.readers.Done()
+	receive := conn.Receive()
+	for notify := range receive {
+		majSoul.callHandleMap(SourceLobby, notify)
+	}
+}
+
+// readFastTestClientConn passes the notifies of conn to the handlers until conn is closed.
+func (majSoul *MajSoul) readFastTestClientConn(conn *network.WsClient) {
+	defer majSoul.lifecycle.readers.Done()
+	receive := conn.Receive()
+	for notify := range receive {
+		majSoul.callHandleMap(SourceGame, notify)
 	}
-	ss.call.Call([]reflect.Value{reflect.ValueOf(majSoul), inValue})
 }
 
 // OnGatewayReconnect sets the callback for when the connection to the gateway server is reestablished.
+// It is called after the session has been restored, see OnLobbyRestored.
 func (majSoul *MajSoul) OnGatewayReconnect(callback func()) {
 	majSoul.onGatewayReconnectCallBack = callback
-	if majSoul.lobbyClientConn != nil {
-		majSoul.lobbyClientConn.ReconnectHandler = callback
-	}
 }
 
 // OnGameReconnect sets the callback for when the connection to the game server is reestablished.
+// It is called after the game has been synchronized again, see OnGameRestored.
 func (majSoul *MajSoul) OnGameReconnect(callback func()) {
 	majSoul.onGameReconnectCallBack = callback
-	if majSoul.fastTestClientConn != nil {
-		majSoul.fastTestClientConn.ReconnectHandler = callback
-	}
+}
+
+// OnReconnectAttempt sets the callback for each failed attempt to reestablish a lost connection.
+func (majSoul *MajSoul) OnReconnectAttempt(callback func(source Source, attempt int, err error)) {
+	majSoul.onReconnectAttemptCallBack = callback
+}
+
+// OnReconnectFailed sets the callback for when a lost connection is given up, see Config.ReconnectPolicy.
+// The connection is closed when it is called, LookupGateway or ConnGame may be called from it to start over.
+func (majSoul *MajSoul) OnReconnectFailed(callback func(source Source, err error)) {
+	majSoul.onReconnectFailedCallBack = callback
+}
+
+// deviceInfo returns the device reported on login.
+func (majSoul *MajSoul) deviceInfo() *message.ClientDeviceInfo {
+	return majSoul.Device.info()
 }
 
 // Login logs in to the Majsoul server with the given account and password.
+// Servers whose profile uses LoginOauth2 are logged in with LoginOauth2 instead.
 func (majSoul *MajSoul) Login(ctx context.Context, account, password string) (*message.ResLogin, error) {
+	if variant := majSoul.loginVariant(); variant != LoginPassword {
+		return nil, fmt.Errorf("server profile %s logs in with %s, not password", majSoul.Profile.Name, variant)
+	}
 	if len(account) == 0 {
 		return nil, fmt.Errorf("account is null")
 	}
@@ -378,58 +421,26 @@ func (majSoul *MajSoul) Login(ctx context.Context, account, password string) (*m
 		t = 1
 	}
 	reqLogin := &message.ReqLogin{
-		Account:   account,
-		Password:  utils.HashPassword(password),
-		Reconnect: false,
-		Device: &message.ClientDeviceInfo{
-			Platform:       "pc",
-			Hardware:       "pc",
-			Os:             "windows",
-			OsVersion:      "win10",
-			IsBrowser:      true,
-			Software:       "Chrome",
-			SalePlatform:   "web",
-			HardwareVendor: "",
-			ModelNumber:    "",
-			ScreenWidth:    uint32(rand.Int31n(400) + 914),
-			ScreenHeight:   uint32(rand.Int31n(200) + 1316),
-		},
-		RandomKey: majSoul.UUID,
-		ClientVersion: &message.ClientVersionInfo{
-			Resource: majSoul.Version.Version,
-			Package:  "",
-		},
+		Account:           account,
+		Password:          utils.HashPassword(password),
+		Reconnect:         false,
+		Device:            majSoul.deviceInfo(),
+		RandomKey:         majSoul.UUID,
+		ClientVersion:     majSoul.CurrentVersion().clientVersionInfo(),
 		GenAccessToken:    true,
-		CurrencyPlatforms: []uint32{2, 6, 8, 10, 11},
+		CurrencyPlatforms: majSoul.currencyPlatforms(),
 		// 电话1 邮箱0
 		Type:                t,
 		Version:             0,
-		ClientVersionString: majSoul.Version.Web(),
-		Tag:                 "cn",
+		ClientVersionString: majSoul.CurrentVersion().Web(),
+		Tag:                 majSoul.loginTag(),
 	}
-	resLogin, err := majSoul.LobbyClient.Login(ctx, reqLogin)
+	resLogin, err := majSoul.lobbyClient().Login(ctx, reqLogin)
 	if err != nil {
 		return nil, err
 	}
-	return resLogin, nil
-}
-
-// ActionPrototype handles actions from the server.
-func (majSoul *MajSoul) ActionPrototype(_ *MajSoul, actionPrototype *message.ActionPrototype) {
-	utils.DecodeActionPrototype(actionPrototype)
-	ss, ok := majSoul.handleMap[actionPrototype.Name]
-	if !ok {
-		logger.Debug("unregistered action", zap.String("name", actionPrototype.Name))
-		return
-	}
-	inValue := reflect.New(ss.in)
-	notify, ok := inValue.Interface().(proto.Message)
-	if !ok {
-		panic("in type not implements proto.Message")
+	if CheckError(resLogin) == nil {
+		majSoul.loggedIn(resLogin)
 	}
-	err := proto.Unmarshal(actionPrototype.Data, notify)
-	if err != nil {
-		panic(fmt.Sprintf("proto unmarshal error %v", err))
-	}
-	ss.call.Call([]reflect.Value{reflect.ValueOf(majSoul), inValue})
+	return resLogin, nil
 }
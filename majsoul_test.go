@@ -0,0 +1,240 @@
+package majsoul
+
+import (
+	"context"
+	"errors"
+	"github.com/constellation39/majsoul/message"
+	"github.com/constellation39/majsoul/network"
+	"net/http"
+	"net/http/httptest"
+	"nhooyr.io/websocket"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testServer stands in for the web server and the gateway of a Majsoul server.
+type testServer struct {
+	*httptest.Server
+	handshakes int32 // websocket handshakes accepted, accessed atomically
+	onVersion  func(w http.ResponseWriter, r *http.Request)
+	onGateway  func(r *http.Request)
+
+	mutex sync.Mutex // Guards conns
+	conns map[*websocket.Conn]struct{}
+}
+
+// newTestServer starts a server answering version.json and accepting websockets on the gateway paths,
+// every websocket is kept open without answering until the client leaves.
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	server := &testServer{conns: make(map[*websocket.Conn]struct{})}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/1/version.json", func(w http.ResponseWriter, r *http.Request) {
+		if server.onVersion != nil {
+			server.onVersion(w, r)
+		}
+		_, _ = w.Write([]byte(`{"version":"0.10.217.w","force_version":"0.10.0.w","code":""}`))
+	})
+	gateway := func(w http.ResponseWriter, r *http.Request) {
+		if server.onGateway != nil {
+			server.onGateway(r)
+		}
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			t.Errorf("accept: %v", err)
+			return
+		}
+		atomic.AddInt32(&server.handshakes, 1)
+		server.mutex.Lock()
+		server.conns[conn] = struct{}{}
+		server.mutex.Unlock()
+		defer func() {
+			server.mutex.Lock()
+			delete(server.conns, conn)
+			server.mutex.Unlock()
+			_ = conn.Close(websocket.StatusInternalError, "")
+		}()
+		for {
+			if _, _, err = conn.Read(r.Context()); err != nil {
+				return
+			}
+		}
+	}
+	mux.HandleFunc(gatewayPath, gateway)
+	mux.HandleFunc(gameGatewayPath, gateway)
+	server.Server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// open returns the number of websockets still open.
+func (server *testServer) open() int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return len(server.conns)
+}
+
+// drop closes every open websocket without a normal closure, so that clients reconnect.
+func (server *testServer) drop() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	for conn := range server.conns {
+		go conn.Close(websocket.StatusGoingAway, "drop")
+	}
+}
+
+// address returns the ServerAddress of the server.
+func (server *testServer) address() *ServerAddress {
+	ws := "ws" + strings.TrimPrefix(server.URL, "http")
+	return &ServerAddress{
+		ServerAddress:  server.URL,
+		GatewayAddress: ws + gatewayPath,
+		GameAddress:    ws + gameGatewayPath,
+	}
+}
+
+// newTestMajSoul returns a MajSoul talking to server only, it is closed when the test ends.
+// options are applied after the ones pointing it at server.
+func newTestMajSoul(t *testing.T, server *testServer, options ...Option) *MajSoul {
+	t.Helper()
+	options = append([]Option{WithRoundTripper(server.Client().Transport)}, options...)
+	majSoul := NewMajSoul(&Config{
+		DisableGatewayDiscovery: true,
+		KeepaliveInterval:       -1,
+		VersionInterval:         -1,
+	}, options...)
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		_ = majSoul.Close(ctx)
+	})
+	return majSoul
+}
+
+func TestReconnectAndClose(t *testing.T) {
+	server := newTestServer(t)
+	majSoul := newTestMajSoul(t, server, func(config *Config) {
+		config.ReconnectPolicy = &network.ReconnectPolicy{InitialDelay: time.Millisecond * 10}
+	})
+	lobbyReconnected := make(chan struct{}, 1)
+	majSoul.OnGatewayReconnect(func() { lobbyReconnected <- struct{}{} })
+	gameReconnected := make(chan struct{}, 1)
+	majSoul.OnGameReconnect(func() { gameReconnected <- struct{}{} })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	// a second lookup or game connection replaces the previous one
+	for i := 0; i < 2; i++ {
+		if err := majSoul.LookupGateway(ctx, []*ServerAddress{server.address()}); err != nil {
+			t.Fatalf("LookupGateway: %v", err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if err := majSoul.ConnGame(ctx); err != nil {
+			t.Fatalf("ConnGame: %v", err)
+		}
+	}
+	waitFor(t, "the replaced connections to close", func() bool { return server.open() == 2 })
+
+	server.drop()
+	for _, reconnected := range []chan struct{}{lobbyReconnected, gameReconnected} {
+		select {
+		case <-reconnected:
+		case <-ctx.Done():
+			t.Fatal("connection was not reestablished")
+		}
+	}
+	if handshakes := atomic.LoadInt32(&server.handshakes); handshakes != 6 {
+		t.Errorf("handshakes = %d, want 6", handshakes)
+	}
+	if state := majSoul.State(); state != StateConnected {
+		t.Errorf("State after reconnect = %s, want %s", state, StateConnected)
+	}
+
+	if err := majSoul.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if state := majSoul.State(); state != StateDisconnected {
+		t.Errorf("State = %s, want %s", state, StateDisconnected)
+	}
+	waitFor(t, "the connections to close", func() bool { return server.open() == 0 })
+	if _, err := majSoul.LobbyClient.Heatbeat(ctx, &message.ReqHeatBeat{}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Heatbeat after Close error = %v, want %v", err, ErrClosed)
+	}
+	if err := majSoul.LookupGateway(ctx, []*ServerAddress{server.address()}); !errors.Is(err, ErrClosed) {
+		t.Errorf("LookupGateway after Close error = %v, want %v", err, ErrClosed)
+	}
+}
+
+func TestCloseDuringLookupGateway(t *testing.T) {
+	server := newTestServer(t)
+	requested := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server.onVersion = func(http.ResponseWriter, *http.Request) {
+		requested <- struct{}{}
+		<-release
+	}
+	majSoul := newTestMajSoul(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	result := make(chan error, 1)
+	go func() {
+		result <- majSoul.LookupGateway(ctx, []*ServerAddress{server.address()})
+	}()
+	select {
+	case <-requested:
+	case <-ctx.Done():
+		t.Fatal("version.json was not requested")
+	}
+	if err := majSoul.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	close(release)
+
+	if err := <-result; !errors.Is(err, ErrClosed) {
+		t.Errorf("LookupGateway error = %v, want %v", err, ErrClosed)
+	}
+	if conn := majSoul.lobbyConn(); conn != nil {
+		t.Error("connection kept after Close")
+	}
+	waitFor(t, "the probed connection to close", func() bool { return server.open() == 0 })
+}
+
+// waitFor fails the test if cond is still false after a few seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second * 5)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func TestLookupGatewayDuringKeepalive(t *testing.T) {
+	server := newTestServer(t)
+	majSoul := newTestMajSoul(t, server, func(config *Config) {
+		config.KeepaliveInterval = time.Millisecond * 5
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if err := majSoul.LookupGateway(ctx, []*ServerAddress{server.address()}); err != nil {
+		t.Fatalf("LookupGateway: %v", err)
+	}
+	majSoul.startKeepalive()
+	for i := 0; i < 5; i++ {
+		time.Sleep(time.Millisecond * 20)
+		if err := majSoul.LookupGateway(ctx, []*ServerAddress{server.address()}); err != nil {
+			t.Fatalf("LookupGateway: %v", err)
+		}
+	}
+	if stats := majSoul.Stats(); stats.FailedHeartbeats == 0 {
+		t.Error("no heartbeat was sent, the test server does not answer them")
+	}
+}
@@ -0,0 +1,3 @@
+package message
+
+//go:generate go run ../cmd/liqigen -json ../proto/liqi.json -proto ../proto/liqi.proto -go_out .
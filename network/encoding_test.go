@@ -0,0 +1,98 @@
+package network
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"github.com/andybalholm/brotli"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testBody = `{"version":"0.10.217.w","force_version":"0.10.0.w","code":""}`
+
+func compress(t *testing.T, newWriter func(w io.Writer) io.WriteCloser, data []byte) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	writer := newWriter(&buffer)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func gzipBody(t *testing.T, data []byte) []byte {
+	return compress(t, func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) }, data)
+}
+
+func zlibBody(t *testing.T, data []byte) []byte {
+	return compress(t, func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) }, data)
+}
+
+func flateBody(t *testing.T, data []byte) []byte {
+	return compress(t, func(w io.Writer) io.WriteCloser {
+		writer, _ := flate.NewWriter(w, flate.DefaultCompression)
+		return writer
+	}, data)
+}
+
+func brotliBody(t *testing.T, data []byte) []byte {
+	return compress(t, func(w io.Writer) io.WriteCloser { return brotli.NewWriter(w) }, data)
+}
+
+func TestRequestContentEncoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+		wantErr  bool
+	}{
+		{"identity", "", []byte(testBody), false},
+		{"gzip", "gzip", gzipBody(t, []byte(testBody)), false},
+		{"zlib deflate", "deflate", zlibBody(t, []byte(testBody)), false},
+		{"raw deflate", "deflate", flateBody(t, []byte(testBody)), false},
+		{"brotli", "br", brotliBody(t, []byte(testBody)), false},
+		{"gzip then brotli", "gzip, br", brotliBody(t, gzipBody(t, []byte(testBody))), false},
+		{"corrupt gzip", "gzip", []byte("not gzip"), true},
+		{"truncated gzip", "gzip", gzipBody(t, []byte(testBody))[:20], true},
+		{"corrupt deflate", "deflate", []byte{0xff, 0xff, 0xff, 0xff}, true},
+		{"corrupt brotli", "br", []byte("not brotli"), true},
+		{"unsupported", "compress", []byte(testBody), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Accept-Encoding"); got != AcceptEncoding {
+					t.Errorf("Accept-Encoding = %q, want %q", got, AcceptEncoding)
+				}
+				if len(test.encoding) != 0 {
+					w.Header().Set("Content-Encoding", test.encoding)
+				}
+				_, _ = w.Write(test.body)
+			}))
+			defer server.Close()
+
+			header := http.Header{}
+			header.Set("Accept-Encoding", AcceptEncoding)
+			body, err := NewRequest(server.URL, header, *server.Client()).Get("1/version.json")
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("Get = %q, want an error", body)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if string(body) != testBody {
+				t.Errorf("Get = %q, want %q", body, testBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"time"
+)
+
+// timeoutCallOption limits the duration of a single Invoke.
+type timeoutCallOption struct {
+	grpc.EmptyCallOption
+	timeout time.Duration
+}
+
+// noRetryCallOption marks a call that must not be retried by RetryInterceptor.
+type noRetryCallOption struct {
+	grpc.EmptyCallOption
+}
+
+// replayCallOption marks a call that is sent again after a reconnect instead of failing with ErrConnectionLost.
+type replayCallOption struct {
+	grpc.EmptyCallOption
+}
+
+// CallTimeout returns a grpc.CallOption that cancels the call after timeout,
+// in addition to any deadline of the context passed to Invoke.
+func CallTimeout(timeout time.Duration) grpc.CallOption {
+	return timeoutCallOption{timeout: timeout}
+}
+
+// NoRetry returns a grpc.CallOption that disables RetryInterceptor for the call.
+func NoRetry() grpc.CallOption {
+	return noRetryCallOption{}
+}
+
+// ReplayOnReconnect returns a grpc.CallOption that keeps the call pending when the connection drops
+// and sends it again once the client has reconnected and the ReconnectHandler has returned.
+func ReplayOnReconnect() grpc.CallOption {
+	return replayCallOption{}
+}
+
+func isReplayOnReconnect(opts []grpc.CallOption) bool {
+	for _, opt := range opts {
+		if _, ok := opt.(replayCallOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNoRetry reports whether opts contains NoRetry, for use by custom retrying interceptors.
+func IsNoRetry(opts []grpc.CallOption) bool {
+	for _, opt := range opts {
+		if _, ok := opt.(noRetryCallOption); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// callTimeout returns the last CallTimeout in opts, 0 if there is none.
+func callTimeout(opts []grpc.CallOption) (timeout time.Duration) {
+	for _, opt := range opts {
+		if o, ok := opt.(timeoutCallOption); ok {
+			timeout = o.timeout
+		}
+	}
+	return
+}
+
+// WithUnaryInterceptors adds interceptors that every Invoke goes through.
+// They run in the given order, the first one is the outermost. The grpc.ClientConn passed to them is always nil.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryClientInterceptor) WsOption {
+	return func(client *WsClient) {
+		client.interceptors = append(client.interceptors, interceptors...)
+	}
+}
+
+// chainUnaryInterceptors composes interceptors around invoker, the first interceptor is the outermost.
+func chainUnaryInterceptors(interceptors []grpc.UnaryClientInterceptor, invoker grpc.UnaryInvoker) grpc.UnaryInvoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return interceptor(ctx, method, req, reply, cc, next, opts...)
+		}
+	}
+	return invoker
+}
+
+// RetryInterceptor retries a failed call up to attempts times in total, waiting backoff between attempts.
+// Calls made with NoRetry and calls whose context is done are not retried.
+func RetryInterceptor(attempts int, backoff time.Duration) grpc.UnaryClientInterceptor {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if IsNoRetry(opts) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		var err error
+		for attempt := 0; attempt < attempts; attempt++ {
+			if attempt > 0 {
+				timer := time.NewTimer(backoff)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return errors.Join(err, ctx.Err())
+				case <-timer.C:
+				}
+			}
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || ctx.Err() != nil {
+				return err
+			}
+		}
+		return err
+	}
+}
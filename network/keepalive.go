@@ -0,0 +1,82 @@
+package network
+
+import (
+	"context"
+	"github.com/constellation39/majsoul/logger"
+	"go.uber.org/zap"
+	"nhooyr.io/websocket"
+	"sync/atomic"
+	"time"
+)
+
+// WithPingInterval makes the client send a websocket ping every interval.
+// A connection whose ping is not answered within the interval is dropped and reconnected.
+// The default is 0, which sends no ping, a connection then is dropped when it receives nothing for a minute.
+func WithPingInterval(interval time.Duration) WsOption {
+	return func(client *WsClient) {
+		client.pingInterval = interval
+	}
+}
+
+// Ping sends a websocket ping and waits for the pong, it returns the round trip time.
+func (client *WsClient) Ping(ctx context.Context) (time.Duration, error) {
+	conn := client.currentConn()
+	if conn == nil || !client.getIsConnected() {
+		return 0, ErrConnectionLost
+	}
+	start := time.Now()
+	if err := conn.Ping(ctx); err != nil {
+		return 0, err
+	}
+	latency := time.Since(start)
+	atomic.StoreInt64(&client.latency, int64(latency))
+	return latency, nil
+}
+
+// Connected reports whether the client currently has a live connection.
+func (client *WsClient) Connected() bool {
+	return client.getIsConnected()
+}
+
+// Latency returns the round trip time of the last successful ping, 0 if none succeeded yet.
+func (client *WsClient) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&client.latency))
+}
+
+// Abort drops the current connection without a normal closure, so that the client reconnects.
+// It is used when the connection is found stale.
+func (client *WsClient) Abort(reason string) {
+	conn := client.currentConn()
+	if conn == nil {
+		return
+	}
+	logger.Info("majsoul ws abort connection", zap.String("address", client.ConnAddress), zap.String("reason", reason))
+	_ = conn.Close(websocket.StatusGoingAway, reason)
+}
+
+// pingLoop pings conn every pingInterval until ctx is done, it aborts the connection when a ping fails.
+func (client *WsClient) pingLoop(ctx context.Context, conn *websocket.Conn) {
+	defer client.loops.Done()
+	ticker := time.NewTicker(client.pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		pingCtx, cancel := context.WithTimeout(ctx, client.pingInterval)
+		start := time.Now()
+		err := conn.Ping(pingCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Warn("majsoul ws ping failed", zap.String("address", client.ConnAddress), zap.Error(err))
+			_ = conn.Close(websocket.StatusGoingAway, "ping timeout")
+			return
+		}
+		atomic.StoreInt64(&client.latency, int64(time.Since(start)))
+	}
+}
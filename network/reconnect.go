@@ -0,0 +1,108 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"github.com/constellation39/majsoul/logger"
+	"go.uber.org/zap"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ReconnectPolicy controls how a WsClient reconnects after its connection drops.
+// Zero durations and multiplier are taken from DefaultReconnectPolicy, so a partially filled policy is usable.
+type ReconnectPolicy struct {
+	InitialDelay time.Duration // Delay before the first attempt
+	MaxDelay     time.Duration // Upper bound of the delay between attempts
+	Multiplier   float64       // Factor applied to the delay after each failed attempt, values below 1 are treated as 1
+	Jitter       float64       // Fraction of the delay randomly added or removed, between 0 and 1
+	MaxAttempts  int           // Attempts before giving up, 0 means never give up
+	DialTimeout  time.Duration // Timeout of a single attempt
+}
+
+// DefaultReconnectPolicy is used by a WsClient created without WithReconnectPolicy.
+var DefaultReconnectPolicy = ReconnectPolicy{
+	InitialDelay: time.Second,
+	MaxDelay:     time.Second * 30,
+	Multiplier:   2,
+	Jitter:       0.2,
+	MaxAttempts:  0,
+	DialTimeout:  time.Second * 5,
+}
+
+// withDefaults returns the policy with its zero InitialDelay, MaxDelay, Multiplier and DialTimeout
+// replaced by the ones of DefaultReconnectPolicy.
+func (policy ReconnectPolicy) withDefaults() ReconnectPolicy {
+	if policy.InitialDelay <= 0 {
+		policy.InitialDelay = DefaultReconnectPolicy.InitialDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultReconnectPolicy.MaxDelay
+	}
+	if policy.Multiplier == 0 {
+		policy.Multiplier = DefaultReconnectPolicy.Multiplier
+	}
+	if policy.DialTimeout <= 0 {
+		policy.DialTimeout = DefaultReconnectPolicy.DialTimeout
+	}
+	return policy
+}
+
+// Delay returns the time to wait before the given attempt, starting at 1.
+func (policy *ReconnectPolicy) Delay(attempt int) time.Duration {
+	p := policy.withDefaults()
+	multiplier := math.Max(p.Multiplier, 1)
+	delay := float64(p.InitialDelay) * math.Pow(multiplier, float64(attempt-1))
+	if delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		delay += delay * math.Min(p.Jitter, 1) * (rand.Float64()*2 - 1)
+	}
+	return time.Duration(delay)
+}
+
+// WithReconnectPolicy sets how the client reconnects, the default is DefaultReconnectPolicy.
+func WithReconnectPolicy(policy ReconnectPolicy) WsOption {
+	return func(client *WsClient) {
+		client.reconnectPolicy = policy.withDefaults()
+	}
+}
+
+// WithContext sets the root context of the client. Once it is done, or Close is called, the client stops reconnecting.
+func WithContext(ctx context.Context) WsOption {
+	return func(client *WsClient) {
+		client.parent = ctx
+	}
+}
+
+// reconnect dials the server again according to the reconnect policy.
+// It returns an error when the policy gives up or the root context is done.
+func (client *WsClient) reconnect() error {
+	policy := client.reconnectPolicy
+	var err error
+	for attempt := 1; policy.MaxAttempts <= 0 || attempt <= policy.MaxAttempts; attempt++ {
+		timer := time.NewTimer(policy.Delay(attempt))
+		select {
+		case <-client.ctx.Done():
+			timer.Stop()
+			return client.ctx.Err()
+		case <-timer.C:
+		}
+
+		{
+			ctx, cancel := context.WithTimeout(client.ctx, policy.DialTimeout)
+			err = client.Connect(ctx)
+			cancel()
+		}
+		if err == nil {
+			return nil
+		}
+		logger.Debug("majsoul ws reconnect failed", zap.String("address", client.ConnAddress), zap.Int("attempt", attempt), zap.Error(err))
+		if client.ReconnectAttemptHandler != nil {
+			client.ReconnectAttemptHandler(attempt, err)
+		}
+	}
+	return fmt.Errorf("majsoul ws gave up reconnecting after %d attempts: %w", policy.MaxAttempts, err)
+}
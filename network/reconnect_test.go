@@ -0,0 +1,49 @@
+package network
+
+import (
+	"nhooyr.io/websocket"
+	"testing"
+	"time"
+)
+
+func TestReconnectPolicyDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  ReconnectPolicy
+		attempt int
+		want    time.Duration
+	}{
+		{"partial policy first attempt", ReconnectPolicy{MaxAttempts: 10}, 1, DefaultReconnectPolicy.InitialDelay},
+		{"partial policy backs off", ReconnectPolicy{MaxAttempts: 10}, 3, DefaultReconnectPolicy.InitialDelay * 4},
+		{"partial policy is bounded", ReconnectPolicy{MaxAttempts: 10}, 20, DefaultReconnectPolicy.MaxDelay},
+		{"initial delay", ReconnectPolicy{InitialDelay: time.Millisecond * 100, Multiplier: 3}, 2, time.Millisecond * 300},
+		{"multiplier below 1", ReconnectPolicy{InitialDelay: time.Second, Multiplier: 0.5}, 5, time.Second},
+		{"max delay", ReconnectPolicy{InitialDelay: time.Second, MaxDelay: time.Second * 3}, 4, time.Second * 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.policy.Delay(test.attempt); got != test.want {
+				t.Errorf("Delay(%d) = %v, want %v", test.attempt, got, test.want)
+			}
+		})
+	}
+}
+
+func TestReconnectPolicyJitter(t *testing.T) {
+	policy := ReconnectPolicy{InitialDelay: time.Second, Jitter: 0.2}
+	for i := 0; i < 100; i++ {
+		if got := policy.Delay(1); got < time.Millisecond*800 || got > time.Millisecond*1200 {
+			t.Fatalf("Delay(1) = %v, want within 20%% of 1s", got)
+		}
+	}
+}
+
+func TestWithReconnectPolicyDefaults(t *testing.T) {
+	client := NewWsClient("ws://localhost", websocket.DialOptions{}, WithReconnectPolicy(ReconnectPolicy{MaxAttempts: 10}))
+	want := DefaultReconnectPolicy
+	want.Jitter = 0
+	want.MaxAttempts = 10
+	if client.reconnectPolicy != want {
+		t.Errorf("reconnectPolicy = %+v, want %+v", client.reconnectPolicy, want)
+	}
+}
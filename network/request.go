@@ -2,6 +2,7 @@ package network
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -33,7 +34,12 @@ func NewRequest(hostAddress string, header http.Header, client http.Client) *Req
 
 // Get sends a GET request to the specified path.
 func (request *Request) Get(path string) ([]byte, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s", request.Host, path), nil)
+	return request.GetContext(context.Background(), path)
+}
+
+// GetContext sends a GET request to the specified path, the request is cancelled once ctx is done.
+func (request *Request) GetContext(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s", request.Host, path), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -43,38 +49,61 @@ func (request *Request) Get(path string) ([]byte, error) {
 
 // Post sends a POST request to the specified path with the provided body.
 func (request *Request) Post(path string, body interface{}) ([]byte, error) {
+	return request.PostContext(context.Background(), path, body)
+}
+
+// PostContext sends a POST request to the specified path with the provided body encoded as JSON,
+// the request is cancelled once ctx is done.
+func (request *Request) PostContext(ctx context.Context, path string, body interface{}) ([]byte, error) {
 	data, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/%s", request.Host, path), bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%s", request.Host, path), bytes.NewReader(data))
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
 
 	return request.do(req)
 }
 
-// do sends the request and returns the response.
+// do sends the request and returns the decoded response body.
+// A Host header overrides the host of the url, the body is decoded according to its Content-Encoding.
 func (request *Request) do(req *http.Request) ([]byte, error) {
-	req.Header = request.header
+	request.rwMutex.RLock()
+	for key, values := range request.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	request.rwMutex.RUnlock()
+	if host := req.Header.Get("Host"); len(host) != 0 {
+		req.Host = host
+		req.Header.Del("Host")
+	}
+
 	res, err := request.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
-	if res.StatusCode != 200 {
+	defer res.Body.Close() // Just close the body, ignore error if any.
+
+	if res.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16)) // let the connection be reused
 		return nil, fmt.Errorf("StatusCode: %s", res.Status)
 	}
 
-	defer res.Body.Close() // Just close the body, ignore error if any.
-
 	resData, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	return resData, nil
+	if res.Uncompressed {
+		return resData, nil
+	}
+	return decodeBody(res.Header.Get("Content-Encoding"), resData)
 }
 
 // GetHeader returns the value(s) of the header for the provided key.
@@ -1,14 +1,16 @@
 package network
 
 import (
-	"bytes"
 	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"github.com/constellation39/majsoul/logger"
 	"github.com/constellation39/majsoul/message"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"math"
 	"nhooyr.io/websocket"
 	"strings"
 	"sync"
@@ -22,35 +24,161 @@ const (
 	msgTypeResponse uint8 = 3
 )
 
+var (
+	// ErrConnectionLost is returned by requests that were in flight, or sent, while the connection was down.
+	ErrConnectionLost = errors.New("majsoul ws connection lost")
+	// ErrClosed is returned by requests made after the client stopped, see Done.
+	ErrClosed = errors.New("majsoul ws client closed")
+)
+
+// writeTimeout limits the time spent writing a single frame.
+const writeTimeout = time.Second * 5
+
+// idleReadTimeout drops a connection that received nothing for that long, it is only used without pings,
+// see WithPingInterval.
+const idleReadTimeout = time.Minute
+
+// staleReplyTimeout is how long the index of an abandoned request stays reserved for its late response.
+const staleReplyTimeout = time.Minute
+
+const (
+	replyPending   int32 = iota // waiting for the response
+	replyDone                   // completed, wait is closed or about to be
+	replyAbandoned              // the caller gave up, the entry only reserves the index
+)
+
 type reply struct {
-	out   proto.Message
-	wait  chan struct{}
-	index uint8
+	out         proto.Message
+	wait        chan struct{}
+	err         error // set before wait is closed
+	index       uint16
+	frame       []byte // request frame, kept to replay the request after a reconnect
+	replay      bool   // whether the request is replayed after a reconnect instead of failing
+	state       int32  // replyPending, replyDone or replyAbandoned, accessed atomically
+	abandonedAt int64  // unix nano time the request was abandoned, accessed atomically
+}
+
+// finish marks the request done. Only the caller that gets true may write out and call complete.
+func (r *reply) finish() bool {
+	return atomic.CompareAndSwapInt32(&r.state, replyPending, replyDone)
+}
+
+// complete closes wait with err. It must only be called after finish returned true.
+func (r *reply) complete(err error) {
+	r.err = err
+	close(r.wait)
+}
+
+// abandon marks the request as given up by its caller, so a late response is dropped.
+func (r *reply) abandon() bool {
+	atomic.StoreInt64(&r.abandonedAt, time.Now().UnixNano())
+	return atomic.CompareAndSwapInt32(&r.state, replyPending, replyAbandoned)
+}
+
+// stale reports whether the index of an abandoned request may be reused.
+func (r *reply) stale() bool {
+	return atomic.LoadInt32(&r.state) == replyAbandoned &&
+		time.Since(time.Unix(0, atomic.LoadInt64(&r.abandonedAt))) > staleReplyTimeout
+}
+
+// OverflowPolicy decides what happens to a message pushed into a full queue.
+type OverflowPolicy int
+
+const (
+	OverflowBlock      OverflowPolicy = iota // Wait until the queue has room, no message is lost
+	OverflowDropNewest                       // Discard the message being pushed
+	OverflowDropOldest                       // Discard the oldest queued message to make room
+)
+
+// Notify is a notify message together with the time it was received.
+type Notify struct {
+	Wrapper *message.Wrapper
+	Time    time.Time
 }
 
 type WsClient struct {
+	connMutex          sync.Mutex // Guards conn and closed
 	conn               *websocket.Conn
+	closed             bool // set once the client stopped, no connection is made after that
 	ConnAddress        string
 	DialOptions        websocket.DialOptions
 	messageIndex       uint32
-	requestResponseMap sync.Map // map[uint8]*reply
-	notify             chan *message.Wrapper
-	ReconnectHandler   func()
+	requestResponseMap sync.Map // map[uint16]*reply
+	notify             chan *Notify
+	overflowPolicy     OverflowPolicy
+	interceptors       []grpc.UnaryClientInterceptor
+	invoker            grpc.UnaryInvoker // invoke wrapped by interceptors
+	reconnectPolicy    ReconnectPolicy
+	pingInterval       time.Duration
+	latency            int64           // round trip of the last ping in nanoseconds, accessed atomically
+	parent             context.Context // context given to WithContext
+	ctx                context.Context // root context derived from parent, cancelled by Close
+	cancel             context.CancelFunc
 	isConnected        uint32
+	loops              sync.WaitGroup // readLoop and pingLoop goroutines
+	stopOnce           sync.Once
+	done               chan struct{} // closed by stop once every loop returned
+
+	DisconnectHandler       func(err error)              // Called when the connection is lost, before reconnecting
+	ReconnectHandler        func()                       // Called after a successful reconnect
+	ReconnectAttemptHandler func(attempt int, err error) // Called after each failed reconnect attempt
+	ReconnectFailedHandler  func(err error)              // Called when the client stops reconnecting
+}
+
+// WsOption configures a WsClient created by NewWsClient.
+type WsOption func(client *WsClient)
+
+// WithNotifyBuffer sets the capacity of the channel returned by Receive, the default is 64.
+func WithNotifyBuffer(size int) WsOption {
+	return func(client *WsClient) {
+		client.notify = make(chan *Notify, size)
+	}
+}
+
+// WithOverflowPolicy sets what happens to a notify received while the Receive channel is full,
+// the default is OverflowBlock.
+func WithOverflowPolicy(policy OverflowPolicy) WsOption {
+	return func(client *WsClient) {
+		client.overflowPolicy = policy
+	}
 }
 
 // NewWsClient creates a new WebSocket client with the specified connection address and dial options.
-func NewWsClient(connAddress string, dialOptions websocket.DialOptions) *WsClient {
-	return &WsClient{
+func NewWsClient(connAddress string, dialOptions websocket.DialOptions, options ...WsOption) *WsClient {
+	client := &WsClient{
+		connMutex:          sync.Mutex{},
 		conn:               nil,
+		closed:             false,
 		ConnAddress:        connAddress,
 		DialOptions:        dialOptions,
 		messageIndex:       0,
 		requestResponseMap: sync.Map{},
-		notify:             make(chan *message.Wrapper, 64),
-		ReconnectHandler:   nil,
+		notify:             make(chan *Notify, 64),
+		overflowPolicy:     OverflowBlock,
+		interceptors:       nil,
+		invoker:            nil,
+		reconnectPolicy:    DefaultReconnectPolicy,
+		pingInterval:       0,
+		latency:            0,
+		parent:             context.Background(),
+		ctx:                nil,
+		cancel:             nil,
 		isConnected:        0,
+		loops:              sync.WaitGroup{},
+		stopOnce:           sync.Once{},
+		done:               make(chan struct{}),
+
+		DisconnectHandler:       nil,
+		ReconnectHandler:        nil,
+		ReconnectAttemptHandler: nil,
+		ReconnectFailedHandler:  nil,
 	}
+	for _, option := range options {
+		option(client)
+	}
+	client.ctx, client.cancel = context.WithCancel(client.parent)
+	client.invoker = chainUnaryInterceptors(client.interceptors, client.invoke)
+	return client
 }
 
 func (client *WsClient) setIsConnected(connected bool) {
@@ -65,79 +193,148 @@ func (client *WsClient) getIsConnected() bool {
 	return atomic.LoadUint32(&client.isConnected) == 1
 }
 
+// currentConn returns the current connection, nil before the first Connect.
+func (client *WsClient) currentConn() *websocket.Conn {
+	client.connMutex.Lock()
+	defer client.connMutex.Unlock()
+	return client.conn
+}
+
 // Connect establishes a connection to the WebSocket server. It returns an error if the connection cannot be established.
+// It returns ErrClosed once the client stopped.
 func (client *WsClient) Connect(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
+	if client.ctx.Err() != nil {
+		return ErrClosed
+	}
 
 	conn, _, err := websocket.Dial(ctx, client.ConnAddress, &client.DialOptions)
 	if err != nil {
 		return fmt.Errorf("majsoul ws failed to dial, error: %v", err)
 	}
 	conn.SetReadLimit(1048576)
+
+	client.connMutex.Lock()
+	if client.closed {
+		client.connMutex.Unlock()
+		_ = conn.Close(websocket.StatusNormalClosure, "")
+		return ErrClosed
+	}
 	client.conn = conn
 	client.setIsConnected(true)
+	client.loops.Add(1)
+	if client.pingInterval > 0 {
+		client.loops.Add(1)
+	}
+	client.connMutex.Unlock()
 
-	go client.readLoop()
+	connCtx, connCancel := context.WithCancel(client.ctx)
+	go client.readLoop(connCtx, connCancel, conn)
+	if client.pingInterval > 0 {
+		go client.pingLoop(connCtx, conn)
+	}
 	return nil
 }
 
-// Receive returns a channel that can be used to receive messages from the WebSocket server.
-func (client *WsClient) Receive() <-chan *message.Wrapper {
+// Receive returns a channel that can be used to receive notify messages from the WebSocket server.
+// The channel is closed once the client stopped, see Done.
+func (client *WsClient) Receive() <-chan *Notify {
 	return client.notify
 }
 
-// Close closes the WebSocket connection. It returns an error if the connection cannot be closed properly.
-func (client *WsClient) Close() error {
-	if !client.getIsConnected() {
-		return fmt.Errorf("majsoul ws client is not connected")
-	}
+// Done returns a channel closed once the client stopped for good: after Close, a normal closure by the server,
+// the end of the context given to WithContext or when the reconnect policy gives up.
+func (client *WsClient) Done() <-chan struct{} {
+	return client.done
+}
 
-	if client.conn != nil {
-		if err := client.conn.Close(websocket.StatusNormalClosure, ""); err != nil {
-			return fmt.Errorf("error while closing websocket connection: %v", err)
+// Closed reports whether the client stopped, or is stopping, for good. See Done.
+func (client *WsClient) Closed() bool {
+	client.connMutex.Lock()
+	defer client.connMutex.Unlock()
+	return client.closed
+}
+
+// Close closes the WebSocket connection and stops reconnecting, the client cannot be connected again.
+// It returns once the read loop returned and the Receive channel is closed.
+func (client *WsClient) Close() error {
+	var err error
+	if conn := client.currentConn(); conn != nil && client.getIsConnected() {
+		err = conn.Close(websocket.StatusNormalClosure, "")
+		if err != nil {
+			err = fmt.Errorf("error while closing websocket connection: %v", err)
 		}
 	}
-	client.conn = nil
-	client.messageIndex = 0
-	client.requestResponseMap = sync.Map{}
-	client.setIsConnected(false)
-	return nil
+	client.stop()
+	<-client.done
+	return err
 }
 
-// readLoop continually reads messages from the WebSocket server and handles them according to their type.
-func (client *WsClient) readLoop() {
-	for {
-		var msgType websocket.MessageType
-		var payload []byte
-		var err error
-		{
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			msgType, payload, err = client.conn.Read(ctx)
-			cancel()
+// stop cancels the root context, then closes Receive and Done once every loop returned.
+// It may be called from a loop, which is why it does not wait.
+func (client *WsClient) stop() {
+	client.stopOnce.Do(func() {
+		client.connMutex.Lock()
+		client.closed = true
+		client.connMutex.Unlock()
+		client.cancel()
+		go func() {
+			client.loops.Wait()
+			client.setIsConnected(false)
+			client.failPending(ErrClosed, true)
+			close(client.notify)
+			close(client.done)
+		}()
+	})
+}
+
+// readLoop continually reads messages from conn and handles them according to their type.
+// A dead connection is detected by the pings sent by pingLoop, or without pings by idleReadTimeout.
+// ctx is cancelled once the connection is lost, which stops pingLoop.
+// ReconnectFailedHandler is called once the loop is done, so that it may close the client.
+func (client *WsClient) readLoop(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn) {
+	var failed error
+	defer func() {
+		cancel()
+		client.loops.Done()
+		if failed != nil && client.ReconnectFailedHandler != nil {
+			client.ReconnectFailedHandler(failed)
 		}
+	}()
+	for {
+		msgType, payload, err := client.read(ctx, conn)
 		if err != nil {
 			client.setIsConnected(false)
-			if websocket.CloseStatus(err) == websocket.StatusNormalClosure {
-				break
+			lost := fmt.Errorf("%w: %v", ErrConnectionLost, err)
+			if websocket.CloseStatus(err) == websocket.StatusNormalClosure || client.ctx.Err() != nil {
+				client.failPending(lost, true)
+				_ = conn.Close(websocket.StatusNormalClosure, "")
+				client.stop()
+				return
 			}
-			for {
-				{
-					ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-					err = client.Connect(ctx)
-					cancel()
-				}
-				if err == nil {
-					if client.ReconnectHandler != nil {
-						client.ReconnectHandler()
-					}
-					break
+			client.failPending(lost, false)
+			if client.DisconnectHandler != nil {
+				client.DisconnectHandler(lost)
+			}
+			if err = client.reconnect(); err != nil {
+				stopped := client.ctx.Err() != nil // Close was called or the context given to WithContext is done
+				client.failPending(fmt.Errorf("%w: %v", ErrConnectionLost, err), true)
+				client.stop()
+				if stopped {
+					return
 				}
-				time.Sleep(time.Second * 5)
+				logger.Error("majsoul ws stopped reconnecting", zap.String("address", client.ConnAddress), zap.Error(err))
+				failed = err
+				return
+			}
+			if client.ReconnectHandler != nil {
+				client.ReconnectHandler()
 			}
+			client.replayPending()
 			return
 		}
 		if msgType != websocket.MessageBinary {
@@ -150,7 +347,7 @@ func (client *WsClient) readLoop() {
 		}
 		switch payload[0] {
 		case msgTypeNotify:
-			client.handleNotify(payload)
+			client.handleNotify(payload, time.Now())
 		case msgTypeResponse:
 			client.handleResponse(payload)
 		default:
@@ -159,8 +356,18 @@ func (client *WsClient) readLoop() {
 	}
 }
 
+// read reads the next message of conn, with idleReadTimeout when no ping checks the connection.
+func (client *WsClient) read(ctx context.Context, conn *websocket.Conn) (websocket.MessageType, []byte, error) {
+	if client.pingInterval <= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, idleReadTimeout)
+		defer cancel()
+	}
+	return conn.Read(ctx)
+}
+
 // handleNotify handles notify messages received from the WebSocket server.
-func (client *WsClient) handleNotify(msg []byte) {
+func (client *WsClient) handleNotify(msg []byte, receiveTime time.Time) {
 	wrapper := new(message.Wrapper)
 
 	err := proto.Unmarshal(msg[1:], wrapper)
@@ -169,60 +376,183 @@ func (client *WsClient) handleNotify(msg []byte) {
 		return
 	}
 
-	select {
-	case client.notify <- wrapper:
+	notify := &Notify{
+		Wrapper: wrapper,
+		Time:    receiveTime,
+	}
+
+	switch client.overflowPolicy {
+	case OverflowDropNewest:
+		select {
+		case client.notify <- notify:
+		default:
+			logger.Warn("notify channel is full, drop newest message", zap.String("name", wrapper.Name))
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case client.notify <- notify:
+				return
+			default:
+			}
+			select {
+			case dropped := <-client.notify:
+				logger.Warn("notify channel is full, drop oldest message", zap.String("name", dropped.Wrapper.Name))
+			default:
+			}
+		}
 	default:
-		logger.Panic("notify channel is full, unable to push new message")
+		select {
+		case client.notify <- notify:
+		case <-client.ctx.Done():
+			logger.Debug("client closed, drop notify", zap.String("name", wrapper.Name))
+		}
 	}
 }
 
 // handleResponse handles response messages received from the WebSocket server.
 func (client *WsClient) handleResponse(msg []byte) {
-	index := (msg[2] << 7) + msg[1]
+	if len(msg) < 3 {
+		logger.Error("response message too short", zap.Int("len", len(msg)))
+		return
+	}
+	index := binary.LittleEndian.Uint16(msg[1:3])
 
-	response, ok := client.requestResponseMap.Load(index)
+	response, ok := client.requestResponseMap.LoadAndDelete(index)
 	if !ok {
+		logger.Warn("response without request", zap.Uint16("index", index))
 		return
 	}
 
 	r, ok := response.(*reply)
 	if !ok {
 		logger.Error("response type is not proto.Message", zap.Reflect("response", response))
+		return
+	}
+
+	if !r.finish() {
+		logger.Debug("drop response of abandoned request", zap.Uint16("index", index))
+		return
 	}
 
 	wrapper := new(message.Wrapper)
 	err := proto.Unmarshal(msg[3:], wrapper)
 	if err != nil {
 		logger.Error("error while unmarshal response message", zap.String("msg", string(msg[3:])))
-
+		r.complete(fmt.Errorf("failed to unmarshal ws response: %w", err))
+		return
 	}
 
 	err = proto.Unmarshal(wrapper.Data, r.out)
 	if err != nil {
 		logger.Error("error while unmarshal wrapper data", zap.String("data", string(wrapper.Data)))
+		r.complete(fmt.Errorf("failed to unmarshal ws response data: %w", err))
+		return
 	}
 
-	close(r.wait)
+	r.complete(nil)
+}
+
+// failPending fails the in-flight requests with err. Requests made with ReplayOnReconnect are kept
+// to be sent again after a reconnect, unless all is set.
+// Indexes reserved by abandoned requests are released since their responses can no longer arrive.
+func (client *WsClient) failPending(err error, all bool) {
+	client.requestResponseMap.Range(func(key, value any) bool {
+		r := value.(*reply)
+		if atomic.LoadInt32(&r.state) == replyAbandoned {
+			client.requestResponseMap.CompareAndDelete(key, r)
+			return true
+		}
+		if r.replay && !all {
+			return true
+		}
+		if client.requestResponseMap.CompareAndDelete(key, r) && r.finish() {
+			r.complete(err)
+		}
+		return true
+	})
+}
+
+// replayPending sends the requests kept by failPending again over the new connection.
+// Requests abandoned by their caller during the reconnect are dropped instead, they must not reach the server.
+func (client *WsClient) replayPending() {
+	client.requestResponseMap.Range(func(key, value any) bool {
+		r := value.(*reply)
+		if atomic.LoadInt32(&r.state) != replyPending {
+			client.requestResponseMap.CompareAndDelete(key, r)
+			return true
+		}
+		err := client.write(r.frame)
+		if err != nil && client.requestResponseMap.CompareAndDelete(key, r) && r.finish() {
+			r.complete(fmt.Errorf("%w: replay failed: %v", ErrConnectionLost, err))
+		}
+		return true
+	})
 }
 
 // Invoke sends a request to the WebSocket server and waits for the response.
-func (client *WsClient) Invoke(ctx context.Context, method string, in interface{}, out interface{}, _ ...grpc.CallOption) error {
+// The call goes through the interceptors added by WithUnaryInterceptors, CallTimeout is honored.
+func (client *WsClient) Invoke(ctx context.Context, method string, in interface{}, out interface{}, opts ...grpc.CallOption) error {
+	if timeout := callTimeout(opts); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return client.invoker(ctx, method, in, out, nil, opts...)
+}
+
+// invoke is the grpc.UnaryInvoker that performs the request.
+func (client *WsClient) invoke(ctx context.Context, method string, in interface{}, out interface{}, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
 	tokens := strings.Split(method, "/")
 	api := strings.Join(tokens, ".")
 
-	r, err := client.sendMsg(ctx, api, in.(proto.Message))
+	r, err := client.sendMsg(ctx, api, in.(proto.Message), out.(proto.Message), isReplayOnReconnect(opts))
 	if err != nil {
 		return err
 	}
-	r.out = out.(proto.Message)
 
 	return client.recvMsg(ctx, r)
 }
 
+// write writes a frame to the connection.
+// The caller's context is not used since websocket closes the connection when a write is cancelled.
+func (client *WsClient) write(frame []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	conn := client.currentConn()
+	if conn == nil {
+		return ErrConnectionLost
+	}
+	return conn.Write(ctx, websocket.MessageBinary, frame)
+}
+
+// register reserves a free request index for r and stores it in requestResponseMap.
+// Indexes still reserved by a pending request, or recently abandoned one, are skipped.
+func (client *WsClient) register(r *reply) error {
+	for i := 0; i <= math.MaxUint16; i++ {
+		r.index = uint16(atomic.AddUint32(&client.messageIndex, 1))
+		actual, loaded := client.requestResponseMap.LoadOrStore(r.index, r)
+		if !loaded {
+			return nil
+		}
+		if old := actual.(*reply); old.stale() && client.requestResponseMap.CompareAndSwap(r.index, old, r) {
+			return nil
+		}
+	}
+	return fmt.Errorf("ws request index exhausted, %d requests in flight", math.MaxUint16+1)
+}
+
 // sendMsg sends a message to the WebSocket server. It returns an error if the message cannot be sent.
-func (client *WsClient) sendMsg(ctx context.Context, api string, in proto.Message) (_ *reply, err error) {
+// The reply is registered before the message is written, so that a fast response finds it.
+func (client *WsClient) sendMsg(ctx context.Context, api string, in proto.Message, out proto.Message, replay bool) (_ *reply, err error) {
+	if client.ctx.Err() != nil {
+		return nil, ErrClosed
+	}
 	if !client.getIsConnected() {
-		return nil, websocket.CloseError{Code: websocket.StatusNoStatusRcvd}
+		return nil, ErrConnectionLost
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
 	}
 
 	var body []byte
@@ -242,36 +572,33 @@ func (client *WsClient) sendMsg(ctx context.Context, api string, in proto.Messag
 		return nil, fmt.Errorf("failed to marshal ws wrapper message: %v, error: %w", wrapper, err)
 	}
 
-	index := atomic.LoadUint32(&client.messageIndex)
-	if index == 255 {
-		index = 0
-		atomic.StoreUint32(&client.messageIndex, index)
-	} else {
-		atomic.AddUint32(&client.messageIndex, 1)
+	r := &reply{
+		out:         out,
+		wait:        make(chan struct{}),
+		err:         nil,
+		index:       0,
+		frame:       nil,
+		replay:      replay,
+		state:       replyPending,
+		abandonedAt: 0,
 	}
 
-	indexUint8 := uint8(index)
-
-	buff := new(bytes.Buffer)
-	buff.WriteByte(msgTypeRequest)
-	buff.WriteByte(indexUint8 - (indexUint8 >> 7 << 7))
-	buff.WriteByte(indexUint8 >> 7)
-	buff.Write(body)
-
-	err = client.conn.Write(ctx, websocket.MessageBinary, buff.Bytes())
-
-	if err != nil {
-		return
+	if err = client.register(r); err != nil {
+		return nil, err
 	}
 
-	r := &reply{
-		out:   nil,
-		wait:  make(chan struct{}),
-		index: indexUint8,
-	}
+	frame := make([]byte, 3, 3+len(body))
+	frame[0] = msgTypeRequest
+	binary.LittleEndian.PutUint16(frame[1:3], r.index)
+	r.frame = append(frame, body...)
 
-	if _, ok := client.requestResponseMap.LoadOrStore(r.index, r); ok {
-		return nil, fmt.Errorf("ws request with index %d already exists", r.index)
+	err = client.write(r.frame)
+	if err != nil {
+		if client.requestResponseMap.CompareAndDelete(r.index, r) {
+			return nil, err
+		}
+		// the connection was lost meanwhile, failPending or replayPending owns the reply now
+		return r, nil
 	}
 
 	return r, nil
@@ -279,13 +606,17 @@ func (client *WsClient) sendMsg(ctx context.Context, api string, in proto.Messag
 
 // recvMsg waits for a response message from the WebSocket server. It returns an error if the response is not received within the context's deadline.
 func (client *WsClient) recvMsg(ctx context.Context, reply *reply) error {
-	defer client.requestResponseMap.Delete(reply.index)
 	select {
 	case <-ctx.Done():
-		return ctx.Err()
+		if reply.abandon() {
+			// keep the entry so that the index is not reused before the late response arrives
+			return ctx.Err()
+		}
+		// the response is being delivered, wait for it so that out is no longer written
+		<-reply.wait
 	case <-reply.wait:
 	}
-	return nil
+	return reply.err
 }
 
 // NewStream is not implemented in this client.
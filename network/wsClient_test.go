@@ -0,0 +1,227 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/constellation39/majsoul/message"
+	"google.golang.org/protobuf/proto"
+	"net/http"
+	"net/http/httptest"
+	"nhooyr.io/websocket"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testFrame is a request received by a test server.
+type testFrame struct {
+	index   uint16
+	wrapper *message.Wrapper
+}
+
+// newTestServer starts a websocket server calling serve for each accepted connection.
+func newTestServer(t *testing.T, serve func(ctx context.Context, conn *websocket.Conn)) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			t.Errorf("accept: %v", err)
+			return
+		}
+		defer conn.Close(websocket.StatusInternalError, "")
+		serve(r.Context(), conn)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestClient returns a WsClient to server, it is not connected yet.
+func newTestClient(server *httptest.Server, options ...WsOption) *WsClient {
+	return NewWsClient("ws"+strings.TrimPrefix(server.URL, "http"), websocket.DialOptions{}, options...)
+}
+
+// connectTestClient connects client, it is closed when the test ends.
+func connectTestClient(t *testing.T, client *WsClient) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+}
+
+func readRequest(ctx context.Context, conn *websocket.Conn) (*testFrame, error) {
+	_, payload, err := conn.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < 3 || payload[0] != msgTypeRequest {
+		return nil, errors.New("not a request frame")
+	}
+	wrapper := new(message.Wrapper)
+	if err = proto.Unmarshal(payload[3:], wrapper); err != nil {
+		return nil, err
+	}
+	return &testFrame{index: binary.LittleEndian.Uint16(payload[1:3]), wrapper: wrapper}, nil
+}
+
+func writeResponse(ctx context.Context, conn *websocket.Conn, index uint16, response proto.Message) error {
+	data, err := proto.Marshal(response)
+	if err != nil {
+		return err
+	}
+	body, err := proto.Marshal(&message.Wrapper{Data: data})
+	if err != nil {
+		return err
+	}
+	frame := make([]byte, 3, 3+len(body))
+	frame[0] = msgTypeResponse
+	binary.LittleEndian.PutUint16(frame[1:3], index)
+	return conn.Write(ctx, websocket.MessageBinary, append(frame, body...))
+}
+
+func TestReplaySkipsAbandonedRequests(t *testing.T) {
+	var conns int32
+	replayed := make(chan *testFrame, 16)
+	server := newTestServer(t, func(ctx context.Context, conn *websocket.Conn) {
+		first := atomic.AddInt32(&conns, 1) == 1
+		for {
+			frame, err := readRequest(ctx, conn)
+			if err != nil {
+				return
+			}
+			if first {
+				// drop the connection while the request is in flight
+				_ = conn.Close(websocket.StatusGoingAway, "drop")
+				return
+			}
+			replayed <- frame
+			if err = writeResponse(ctx, conn, frame.index, &message.ResCommon{}); err != nil {
+				return
+			}
+		}
+	})
+	client := newTestClient(server, WithReconnectPolicy(ReconnectPolicy{
+		InitialDelay: time.Millisecond * 300,
+		DialTimeout:  time.Second,
+	}))
+	reconnected := make(chan struct{})
+	client.ReconnectHandler = func() { close(reconnected) }
+	connectTestClient(t, client)
+	fastTest := message.NewFastTestClient(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	_, err := fastTest.InputOperation(ctx, &message.ReqSelfOperation{}, ReplayOnReconnect())
+	cancel()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("InputOperation error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-reconnected:
+	case <-time.After(time.Second * 5):
+		t.Fatal("client did not reconnect")
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if _, err = fastTest.CheckNetworkDelay(ctx, &message.ReqCommon{}); err != nil {
+		t.Fatalf("CheckNetworkDelay: %v", err)
+	}
+
+	// leave time to a late replay before looking at what the new connection received
+	time.Sleep(time.Millisecond * 100)
+	for len(replayed) != 0 {
+		if frame := <-replayed; frame.wrapper.Name != ".lq.FastTest.checkNetworkDelay" {
+			t.Errorf("new connection received %s, the abandoned request was replayed", frame.wrapper.Name)
+		}
+	}
+	if _, ok := client.requestResponseMap.Load(uint16(1)); ok {
+		t.Error("abandoned request is still registered")
+	}
+}
+
+func TestConcurrentInvokeOutOfOrder(t *testing.T) {
+	const calls = 1000
+	server := newTestServer(t, func(ctx context.Context, conn *websocket.Conn) {
+		// answer every request once all of them arrived, in reverse order
+		frames := make([]*testFrame, 0, calls)
+		for len(frames) < calls {
+			frame, err := readRequest(ctx, conn)
+			if err != nil {
+				return
+			}
+			frames = append(frames, frame)
+		}
+		for i := len(frames) - 1; i >= 0; i-- {
+			in := new(message.ReqRoomKick)
+			if err := proto.Unmarshal(frames[i].wrapper.Data, in); err != nil {
+				t.Errorf("unmarshal request: %v", err)
+				return
+			}
+			out := &message.ResCommon{Error: &message.Error{Code: in.AccountId}}
+			if err := writeResponse(ctx, conn, frames[i].index, out); err != nil {
+				return
+			}
+		}
+		_, _, _ = conn.Read(ctx)
+	})
+	client := newTestClient(server)
+	connectTestClient(t, client)
+	lobby := message.NewLobbyClient(client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	errs := make(chan error, calls)
+	for i := 1; i <= calls; i++ {
+		go func(id uint32) {
+			out, err := lobby.KickPlayer(ctx, &message.ReqRoomKick{AccountId: id})
+			if err == nil && out.Error.GetCode() != id {
+				err = fmt.Errorf("call %d got the response of call %d", id, out.Error.GetCode())
+			}
+			errs <- err
+		}(uint32(i))
+	}
+	for i := 0; i < calls; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCloseFromReconnectFailedHandler(t *testing.T) {
+	var conns int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&conns, 1) > 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			t.Errorf("accept: %v", err)
+			return
+		}
+		_ = conn.Close(websocket.StatusGoingAway, "drop")
+	}))
+	t.Cleanup(server.Close)
+	client := newTestClient(server, WithReconnectPolicy(ReconnectPolicy{
+		InitialDelay: time.Millisecond * 10,
+		MaxAttempts:  1,
+		DialTimeout:  time.Second,
+	}))
+	closed := make(chan error, 1)
+	client.ReconnectFailedHandler = func(error) { closed <- client.Close() }
+	connectTestClient(t, client)
+
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("Close called from ReconnectFailedHandler did not return")
+	}
+}
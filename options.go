@@ -0,0 +1,105 @@
+package majsoul
+
+import (
+	"github.com/constellation39/majsoul/network"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRequestTimeout = time.Second * 5                                            // Used when Config.RequestTimeout is 0
+	defaultDialTimeout    = time.Second * 5                                            // Used when Config.DialTimeout is 0
+	defaultAcceptLanguage = "zh-CN,zh;q=0.9,ja;q=0.8,en;q=0.7,en-GB;q=0.6,en-US;q=0.5" // Used when Config.AcceptLanguage is empty
+	defaultLoginTag       = "cn"                                                       // Used when neither Config nor the server profile set a login tag
+)
+
+// defaultCurrencyPlatforms is used when neither Config nor the server profile set currency platforms.
+var defaultCurrencyPlatforms = []uint32{2, 6, 8, 10, 11}
+
+// Option configures a MajSoul created by NewMajSoul, it is applied to a copy of the Config.
+type Option func(config *Config)
+
+// WithDeviceProfile sets the device reported on login.
+func WithDeviceProfile(profile *DeviceProfile) Option {
+	return func(config *Config) {
+		config.DeviceProfile = profile
+	}
+}
+
+// WithUserAgent sets the User-Agent of HTTP requests and websocket handshakes.
+func WithUserAgent(userAgent string) Option {
+	return func(config *Config) {
+		config.UserAgent = userAgent
+	}
+}
+
+// WithLanguage sets the tag sent by Login, e.g. "cn", "jp" or "en", and the Accept-Language header.
+func WithLanguage(tag, acceptLanguage string) Option {
+	return func(config *Config) {
+		config.LoginTag = tag
+		config.AcceptLanguage = acceptLanguage
+	}
+}
+
+// WithCurrencyPlatforms sets the currency platforms sent on login.
+func WithCurrencyPlatforms(platforms ...uint32) Option {
+	return func(config *Config) {
+		config.CurrencyPlatforms = platforms
+	}
+}
+
+// WithDialTimeout sets the timeout of websocket handshakes.
+func WithDialTimeout(timeout time.Duration) Option {
+	return func(config *Config) {
+		config.DialTimeout = timeout
+	}
+}
+
+// WithRequestTimeout sets the timeout of HTTP requests, it is ignored when WithHTTPClient is used.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(config *Config) {
+		config.RequestTimeout = timeout
+	}
+}
+
+// WithHTTPClient sets the client of HTTP requests, replacing the one built from the proxy, Dialer and RequestTimeout.
+func WithHTTPClient(client *http.Client) Option {
+	return func(config *Config) {
+		config.HTTPClient = client
+	}
+}
+
+// WithRegistry sets the registry decoding the notifies and actions unknown to the message package.
+func WithRegistry(registry *Registry) Option {
+	return func(config *Config) {
+		config.Registry = registry
+	}
+}
+
+func (config *Config) userAgent() string {
+	if len(config.UserAgent) == 0 {
+		return network.UserAgent
+	}
+	return config.UserAgent
+}
+
+func (config *Config) acceptLanguage() string {
+	if len(config.AcceptLanguage) == 0 {
+		return defaultAcceptLanguage
+	}
+	return config.AcceptLanguage
+}
+
+func (config *Config) dialTimeout() time.Duration {
+	if config.DialTimeout <= 0 {
+		return defaultDialTimeout
+	}
+	return config.DialTimeout
+}
+
+func (config *Config) requestTimeout() time.Duration {
+	if config.RequestTimeout <= 0 {
+		return defaultRequestTimeout
+	}
+	return config.RequestTimeout
+}
@@ -0,0 +1,261 @@
+package majsoul
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/constellation39/majsoul/message"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// LoginVariant selects the RPCs used to log in to a server.
+type LoginVariant string
+
+const (
+	LoginPassword LoginVariant = "password" // Login with account and password
+	LoginOauth2   LoginVariant = "oauth2"   // Oauth2Auth with a code of the platform account, then Oauth2Login, see LoginOauth2
+)
+
+// Names of the built-in server profiles.
+const (
+	ProfileCN = "cn"
+	ProfileJP = "jp"
+	ProfileEN = "en"
+)
+
+// ServerProfile bundles what differs between the regional servers.
+type ServerProfile struct {
+	Name              string           `json:"name"`
+	Servers           []*ServerAddress `json:"servers"`           // Probed in parallel by LookupGatewayProfile, the fastest one is kept
+	ResourceURL       string           `json:"resourceUrl"`       // Base URL of version.json and resources, defaults to the ServerAddress in use
+	LoginTag          string           `json:"loginTag"`          // Tag sent by Login
+	CurrencyPlatforms []uint32         `json:"currencyPlatforms"` // Currency platforms sent on login
+	LoginVariant      LoginVariant     `json:"loginVariant"`      // RPCs used to log in, defaults to LoginPassword
+	Oauth2Type        uint32           `json:"oauth2Type"`        // Type sent by Oauth2Auth, Oauth2Check and Oauth2Login
+}
+
+var (
+	profileMutex sync.RWMutex
+	profiles     = map[string]*ServerProfile{
+		ProfileCN: {
+			Name:              ProfileCN,
+			Servers:           ServerAddressList,
+			ResourceURL:       "",
+			LoginTag:          "cn",
+			CurrencyPlatforms: []uint32{2, 6, 8, 10, 11},
+			LoginVariant:      LoginPassword,
+			Oauth2Type:        0,
+		},
+		ProfileJP: {
+			Name: ProfileJP,
+			Servers: []*ServerAddress{
+				{
+					ServerAddress:  "https://game.mahjongsoul.com",
+					GatewayAddress: "wss://mjjpgs.mahjongsoul.com:9663/gateway",
+					GameAddress:    "wss://mjjpgs.mahjongsoul.com:9663/game-gateway",
+				},
+			},
+			ResourceURL:       "",
+			LoginTag:          "jp",
+			CurrencyPlatforms: []uint32{1, 3, 5, 9, 12},
+			LoginVariant:      LoginOauth2,
+			Oauth2Type:        7,
+		},
+		ProfileEN: {
+			Name: ProfileEN,
+			Servers: []*ServerAddress{
+				{
+					ServerAddress:  "https://mahjongsoul.game.yo-star.com",
+					GatewayAddress: "wss://mjusgs.mahjongsoul.com:9663/gateway",
+					GameAddress:    "wss://mjusgs.mahjongsoul.com:9663/game-gateway",
+				},
+			},
+			ResourceURL:       "",
+			LoginTag:          "en",
+			CurrencyPlatforms: []uint32{1, 4, 5, 9, 12},
+			LoginVariant:      LoginOauth2,
+			Oauth2Type:        8,
+		},
+	}
+)
+
+// RegisterServerProfile adds a copy of profile to the registry, replacing the profile of the same name.
+// profile is not modified, later changes to it do not affect the registered copy.
+func RegisterServerProfile(profile *ServerProfile) error {
+	profile = profile.clone()
+	if len(profile.Name) == 0 {
+		return fmt.Errorf("server profile name is empty")
+	}
+	if len(profile.Servers) == 0 {
+		return fmt.Errorf("server profile %s has no server", profile.Name)
+	}
+	switch profile.LoginVariant {
+	case "":
+		profile.LoginVariant = LoginPassword
+	case LoginPassword, LoginOauth2:
+	default:
+		return fmt.Errorf("server profile %s has unknown login variant %s", profile.Name, profile.LoginVariant)
+	}
+	profileMutex.Lock()
+	defer profileMutex.Unlock()
+	profiles[strings.ToLower(profile.Name)] = profile
+	return nil
+}
+
+// clone returns a copy of profile that shares no slice or address with it.
+func (profile *ServerProfile) clone() *ServerProfile {
+	copied := *profile
+	copied.Servers = make([]*ServerAddress, 0, len(profile.Servers))
+	for _, server := range profile.Servers {
+		address := *server
+		copied.Servers = append(copied.Servers, &address)
+	}
+	copied.CurrencyPlatforms = append([]uint32(nil), profile.CurrencyPlatforms...)
+	return &copied
+}
+
+// RegisterServerProfilesJSON registers the profiles decoded from data, either a single profile or an array of them.
+func RegisterServerProfilesJSON(data []byte) error {
+	var list []*ServerProfile
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(data, &list); err != nil {
+			return fmt.Errorf("decode server profiles: %w", err)
+		}
+	} else {
+		profile := new(ServerProfile)
+		if err := json.Unmarshal(data, profile); err != nil {
+			return fmt.Errorf("decode server profile: %w", err)
+		}
+		list = append(list, profile)
+	}
+	for _, profile := range list {
+		if err := RegisterServerProfile(profile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LookupServerProfile returns the profile registered under name, names are case insensitive.
+func LookupServerProfile(name string) (*ServerProfile, bool) {
+	profileMutex.RLock()
+	defer profileMutex.RUnlock()
+	profile, ok := profiles[strings.ToLower(name)]
+	return profile, ok
+}
+
+// ServerProfileNames returns the names of the registered profiles in order.
+func ServerProfileNames() []string {
+	profileMutex.RLock()
+	defer profileMutex.RUnlock()
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// contains reports whether serverAddress is one of the servers of profile, which may be nil.
+func (profile *ServerProfile) contains(serverAddress *ServerAddress) bool {
+	if profile == nil {
+		return false
+	}
+	for _, server := range profile.Servers {
+		if server.GatewayAddress == serverAddress.GatewayAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// profileOf returns the registered profile containing serverAddress, nil if there is none.
+func profileOf(serverAddress *ServerAddress) *ServerProfile {
+	profileMutex.RLock()
+	defer profileMutex.RUnlock()
+	for _, name := range []string{ProfileCN, ProfileJP, ProfileEN} {
+		if profile := profiles[name]; profile.contains(serverAddress) {
+			return profile
+		}
+	}
+	for _, profile := range profiles {
+		if profile.contains(serverAddress) {
+			return profile
+		}
+	}
+	return nil
+}
+
+// LookupGatewayProfile looks up the gateway among the servers of the profile registered under name.
+func (majSoul *MajSoul) LookupGatewayProfile(ctx context.Context, name string) error {
+	profile, ok := LookupServerProfile(name)
+	if !ok {
+		return fmt.Errorf("unknown server profile %s", name)
+	}
+	majSoul.Profile = profile
+	return majSoul.LookupGateway(ctx, profile.Servers)
+}
+
+func (majSoul *MajSoul) loginTag() string {
+	if len(majSoul.config.LoginTag) != 0 {
+		return majSoul.config.LoginTag
+	}
+	if profile := majSoul.Profile; profile != nil && len(profile.LoginTag) != 0 {
+		return profile.LoginTag
+	}
+	return defaultLoginTag
+}
+
+func (majSoul *MajSoul) currencyPlatforms() []uint32 {
+	if len(majSoul.config.CurrencyPlatforms) != 0 {
+		return majSoul.config.CurrencyPlatforms
+	}
+	if profile := majSoul.Profile; profile != nil && len(profile.CurrencyPlatforms) != 0 {
+		return profile.CurrencyPlatforms
+	}
+	return defaultCurrencyPlatforms
+}
+
+func (majSoul *MajSoul) loginVariant() LoginVariant {
+	if profile := majSoul.Profile; profile != nil && len(profile.LoginVariant) != 0 {
+		return profile.LoginVariant
+	}
+	return LoginPassword
+}
+
+func (majSoul *MajSoul) oauth2Type() uint32 {
+	if profile := majSoul.Profile; profile != nil {
+		return profile.Oauth2Type
+	}
+	return 0
+}
+
+// resourceURL returns the base URL of version.json and resources for serverAddress of profile, which may be nil.
+func resourceURL(profile *ServerProfile, serverAddress *ServerAddress) string {
+	if profile != nil && len(profile.ResourceURL) != 0 {
+		return profile.ResourceURL
+	}
+	return serverAddress.ServerAddress
+}
+
+// LoginOauth2 logs in to a server using LoginOauth2, with the code and uid issued by the platform account, e.g. Yostar.
+func (majSoul *MajSoul) LoginOauth2(ctx context.Context, code, uid string) (*message.ResLogin, error) {
+	if len(code) == 0 {
+		return nil, fmt.Errorf("code is null")
+	}
+	resOauth2Auth, err := majSoul.lobbyClient().Oauth2Auth(ctx, &message.ReqOauth2Auth{
+		Type:                majSoul.oauth2Type(),
+		Code:                code,
+		Uid:                 uid,
+		ClientVersionString: majSoul.CurrentVersion().Web(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckError(resOauth2Auth); err != nil {
+		return nil, fmt.Errorf("oauth2 auth: %w", err)
+	}
+	return majSoul.loginWithToken(ctx, resOauth2Auth.AccessToken)
+}
@@ -0,0 +1,41 @@
+package majsoul
+
+import (
+	"testing"
+)
+
+func TestRegisterServerProfileCopies(t *testing.T) {
+	profile := &ServerProfile{
+		Name: "test",
+		Servers: []*ServerAddress{{
+			ServerAddress:  "https://example.com",
+			GatewayAddress: "wss://example.com/gateway",
+			GameAddress:    "wss://example.com/game-gateway",
+		}},
+		CurrencyPlatforms: []uint32{1},
+	}
+	if err := RegisterServerProfile(profile); err != nil {
+		t.Fatalf("RegisterServerProfile: %v", err)
+	}
+	t.Cleanup(func() {
+		profileMutex.Lock()
+		delete(profiles, "test")
+		profileMutex.Unlock()
+	})
+	if profile.LoginVariant != "" {
+		t.Errorf("LoginVariant of the argument = %q, want it unchanged", profile.LoginVariant)
+	}
+
+	profile.Servers[0].GatewayAddress = "wss://changed/gateway"
+	profile.CurrencyPlatforms[0] = 2
+	registered, ok := LookupServerProfile("TEST")
+	if !ok {
+		t.Fatal("profile not registered")
+	}
+	if registered.LoginVariant != LoginPassword {
+		t.Errorf("LoginVariant = %q, want %q", registered.LoginVariant, LoginPassword)
+	}
+	if registered.Servers[0].GatewayAddress != "wss://example.com/gateway" || registered.CurrencyPlatforms[0] != 1 {
+		t.Errorf("registered profile changed with its argument: %+v", registered)
+	}
+}
@@ -0,0 +1,103 @@
+package majsoul
+
+import (
+	"context"
+	"fmt"
+	"golang.org/x/net/proxy"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+// ContextDialer dials the connections of a MajSoul, e.g. a *net.Dialer bound to a local address.
+type ContextDialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// proxyPoolIndex spreads the MajSouls created with Config.ProxyAddresses over the pool.
+var proxyPoolIndex uint32
+
+// WithProxy sets the proxy of every connection, see Config.ProxyAddress.
+func WithProxy(address string) Option {
+	return func(config *Config) {
+		config.ProxyAddress = address
+	}
+}
+
+// WithProxyPool sets the proxies shared by the MajSouls created with the Config, see Config.ProxyAddresses.
+func WithProxyPool(addresses ...string) Option {
+	return func(config *Config) {
+		config.ProxyAddresses = addresses
+	}
+}
+
+// WithDialer sets the dialer of every connection, or of the proxy when one is set.
+func WithDialer(dialer ContextDialer) Option {
+	return func(config *Config) {
+		config.Dialer = dialer
+	}
+}
+
+// selectProxy returns ProxyAddress, or the next address of ProxyAddresses.
+func (config *Config) selectProxy() string {
+	if len(config.ProxyAddress) != 0 || len(config.ProxyAddresses) == 0 {
+		return config.ProxyAddress
+	}
+	index := atomic.AddUint32(&proxyPoolIndex, 1) - 1
+	return config.ProxyAddresses[index%uint32(len(config.ProxyAddresses))]
+}
+
+// contextDialer adapts a ContextDialer to the proxy.Dialer used as the forward dialer of a SOCKS5 proxy.
+type contextDialer struct {
+	ContextDialer
+}
+
+func (dialer contextDialer) Dial(network, address string) (net.Conn, error) {
+	return dialer.DialContext(context.Background(), network, address)
+}
+
+// newTransport returns a Transport dialing with Config.Dialer through the proxy selected for majSoul.
+func (majSoul *MajSoul) newTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	var dialer ContextDialer = &net.Dialer{
+		Timeout:   time.Second * 30,
+		KeepAlive: time.Second * 30,
+	}
+	if majSoul.config.Dialer != nil {
+		dialer = majSoul.config.Dialer
+		transport.Proxy = nil
+	}
+	transport.DialContext = dialer.DialContext
+	if len(majSoul.proxyAddress) == 0 {
+		return transport, nil
+	}
+
+	proxyURL, err := url.Parse(majSoul.proxyAddress)
+	if err != nil {
+		return nil, fmt.Errorf("parse proxy address error %v", err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https":
+		transport.Proxy = http.ProxyURL(proxyURL)
+	case "socks5", "socks5h":
+		var auth *proxy.Auth
+		if proxyURL.User != nil {
+			password, _ := proxyURL.User.Password()
+			auth = &proxy.Auth{
+				User:     proxyURL.User.Username(),
+				Password: password,
+			}
+		}
+		socks, err := proxy.SOCKS5("tcp", proxyURL.Host, auth, contextDialer{dialer})
+		if err != nil {
+			return nil, fmt.Errorf("socks5 proxy error %v", err)
+		}
+		transport.Proxy = nil
+		transport.DialContext = socks.(proxy.ContextDialer).DialContext
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %s", proxyURL.Scheme)
+	}
+	return transport, nil
+}
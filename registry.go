@@ -0,0 +1,210 @@
+package majsoul
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/constellation39/majsoul/liqi"
+	"github.com/constellation39/majsoul/network"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"os"
+	"strings"
+)
+
+// ErrNoRegistry is returned by InvokeDynamic when no Registry is set.
+var ErrNoRegistry = errors.New("majsoul: no registry, see SetRegistry")
+
+// Registry holds the messages and rpcs of a liqi.json loaded at runtime, as dynamicpb types.
+// Set on a MajSoul, notifies and actions unknown to the message package are decoded with it into *dynamicpb.Message,
+// so that new game events can be observed with OnName or Events before the message package is regenerated.
+type Registry struct {
+	file  protoreflect.FileDescriptor
+	types *protoregistry.Types
+}
+
+// NewRegistry builds a Registry from the content of a liqi.json.
+func NewRegistry(liqiJSON []byte) (*Registry, error) {
+	file, err := liqi.Parse(liqiJSON)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := file.FileDescriptor("liqi.proto")
+	if err != nil {
+		return nil, err
+	}
+	registry := &Registry{
+		file:  fd,
+		types: new(protoregistry.Types),
+	}
+	if err = registry.register(fd.Messages(), fd.Enums()); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// LoadRegistry builds a Registry from the liqi.json at path.
+func LoadRegistry(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewRegistry(data)
+}
+
+func (registry *Registry) register(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors) error {
+	for i := 0; i < enums.Len(); i++ {
+		if err := registry.types.RegisterEnum(dynamicpb.NewEnumType(enums.Get(i))); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if err := registry.types.RegisterMessage(dynamicpb.NewMessageType(md)); err != nil {
+			return err
+		}
+		if err := registry.register(md.Messages(), md.Enums()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindMessageByName returns the type of the message named name, e.g. "lq.NotifyGamePause", ".lq.NotifyGamePause"
+// or "ActionDiscardTile".
+func (registry *Registry) FindMessageByName(name string) (protoreflect.MessageType, bool) {
+	mt, err := registry.types.FindMessageByName(fullName(name))
+	if err != nil {
+		return nil, false
+	}
+	return mt, true
+}
+
+// NewMessage returns an empty message named name, to be filled and passed to InvokeDynamic.
+func (registry *Registry) NewMessage(name string) (*dynamicpb.Message, error) {
+	mt, ok := registry.FindMessageByName(name)
+	if !ok {
+		return nil, fmt.Errorf("majsoul: message %s not in registry", name)
+	}
+	return dynamicpb.NewMessage(mt.Descriptor()), nil
+}
+
+// FindMethod returns the rpc named method, e.g. "lq.Lobby.fetchServerSettings" or "/lq.Lobby/fetchServerSettings".
+func (registry *Registry) FindMethod(method string) (protoreflect.MethodDescriptor, bool) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "."))
+	services := registry.file.Services()
+	service := services.ByName(protoreflect.Name(name.Parent().Name()))
+	if service == nil || service.FullName() != name.Parent() {
+		return nil, false
+	}
+	md := service.Methods().ByName(name.Name())
+	return md, md != nil
+}
+
+// SetRegistry sets the registry decoding the notifies and actions unknown to the message package, nil removes it.
+func (majSoul *MajSoul) SetRegistry(registry *Registry) {
+	majSoul.handleMutex.Lock()
+	defer majSoul.handleMutex.Unlock()
+	majSoul.registry = registry
+}
+
+// Registry returns the registry set by SetRegistry or Config.Registry, nil if there is none.
+func (majSoul *MajSoul) Registry() *Registry {
+	majSoul.handleMutex.RLock()
+	defer majSoul.handleMutex.RUnlock()
+	return majSoul.registry
+}
+
+// FetchRegistry downloads the liqi.json of the version in use, caching it in dir unless it is empty,
+// and sets the Registry built from it.
+func (majSoul *MajSoul) FetchRegistry(ctx context.Context, dir string) (*Registry, error) {
+	downloader, err := majSoul.Resources(dir)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := downloader.Manifest(ctx, majSoul.CurrentVersion().Version)
+	if err != nil {
+		return nil, err
+	}
+	data, err := downloader.Liqi(ctx, manifest)
+	if err != nil {
+		return nil, err
+	}
+	registry, err := NewRegistry(data)
+	if err != nil {
+		return nil, err
+	}
+	majSoul.SetRegistry(registry)
+	return registry, nil
+}
+
+// OnName registers callback for the notify or action named name, e.g. "lq.NotifyGamePause" or "ActionDiscardTile".
+// The message is of the generated type when the message package knows it, otherwise a *dynamicpb.Message
+// decoded with the Registry; it is shared between subscribers and must not be modified.
+func (majSoul *MajSoul) OnName(name string, callback func(majSoul *MajSoul, msg proto.Message)) *Subscription {
+	if callback == nil {
+		panic("majsoul: OnName callback is nil")
+	}
+	return majSoul.OnNameE(name, func(majSoul *MajSoul, msg proto.Message) error {
+		callback(majSoul, msg)
+		return nil
+	})
+}
+
+// OnNameE is like OnName but the callback may return an error, which is reported to the OnHandlerError callback.
+func (majSoul *MajSoul) OnNameE(name string, callback func(majSoul *MajSoul, msg proto.Message) error) *Subscription {
+	if callback == nil {
+		panic("majsoul: OnNameE callback is nil")
+	}
+	s := &Subscription{
+		majSoul: majSoul,
+		name:    fullName(name),
+		sub: &subscribe{
+			messageType: nil,
+			call:        callback,
+		},
+	}
+	majSoul.handleMutex.Lock()
+	defer majSoul.handleMutex.Unlock()
+	subs := majSoul.handleMap[s.name]
+	// copy on write so that dispatch can iterate without holding the lock
+	majSoul.handleMap[s.name] = append(subs[:len(subs):len(subs)], s.sub)
+	return s
+}
+
+// InvokeDynamic calls the rpc method of the Registry, e.g. "lq.Lobby.fetchServerSettings", on the lobby or game
+// connection, so that rpcs missing from LobbyClient and FastTestClient can be called.
+// req must be of the request type of the rpc, for example built with Registry.NewMessage;
+// the response is a *dynamicpb.Message.
+func (majSoul *MajSoul) InvokeDynamic(ctx context.Context, method string, req proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+	registry := majSoul.Registry()
+	if registry == nil {
+		return nil, ErrNoRegistry
+	}
+	md, ok := registry.FindMethod(method)
+	if !ok {
+		return nil, fmt.Errorf("majsoul: rpc %s not in registry", method)
+	}
+	if name := req.ProtoReflect().Descriptor().FullName(); name != md.Input().FullName() {
+		return nil, fmt.Errorf("majsoul: rpc %s takes %s, not %s", md.FullName(), md.Input().FullName(), name)
+	}
+	var conn *network.WsClient
+	switch md.Parent().Name() {
+	case "Lobby":
+		conn = majSoul.lobbyConn()
+	case "FastTest":
+		conn = majSoul.gameConn()
+	}
+	if conn == nil {
+		return nil, fmt.Errorf("majsoul: no connection for %s", md.Parent().FullName())
+	}
+	res := dynamicpb.NewMessage(md.Output())
+	err := conn.Invoke(ctx, fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name()), req, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,262 @@
+// Package resources downloads the resources of the Majsoul web client, such as liqi.json and the config tables.
+//
+// Every resource is served under a prefix naming the client version it last changed in,
+// the prefixes are listed by the manifest resversion<version>.json.
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/constellation39/majsoul/network"
+	"google.golang.org/protobuf/encoding/protowire"
+	"math/rand"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	clientPath = "1" // Directory of the web client on a server, version.json and the resources are under it
+
+	LiqiPath         = "res/proto/liqi.json"  // Protocol definition used by the client
+	ConfigTablesPath = "res/config/lqc.lqbin" // Config tables used by the client
+)
+
+// ErrNotInManifest is returned when a resource is not listed by the manifest.
+var ErrNotInManifest = errors.New("resources: resource not in manifest")
+
+// Entry is a resource listed by a Manifest.
+type Entry struct {
+	Prefix string `json:"prefix"`         // Version the resource last changed in, e.g. "v0.10.217.w"
+	Size   int64  `json:"size,omitempty"` // Length in bytes, 0 if the manifest does not list it
+}
+
+// Manifest is the resversion file of a client version, mapping each resource to its prefix.
+type Manifest struct {
+	Version string           `json:"-"` // Client version the manifest was fetched for
+	Res     map[string]Entry `json:"res"`
+}
+
+// Prefix returns the prefix of the resource at name.
+func (manifest *Manifest) Prefix(name string) (string, bool) {
+	entry, ok := manifest.Res[name]
+	if !ok || len(entry.Prefix) == 0 {
+		return "", false
+	}
+	return entry.Prefix, true
+}
+
+// Path returns the path the resource at name is served from, name itself if it is not listed.
+func (manifest *Manifest) Path(name string) string {
+	if prefix, ok := manifest.Prefix(name); ok {
+		return fmt.Sprintf("%s/%s", prefix, name)
+	}
+	return name
+}
+
+// Downloader fetches resources through a network.Request whose host is the address of a server,
+// e.g. https://game.maj-soul.com.
+// Manifests and resources are cached in Dir, resources under the directory of their prefix.
+type Downloader struct {
+	request *network.Request
+	Dir     string // Cache directory, empty disables the cache
+}
+
+// NewDownloader creates a Downloader using request, caching to dir unless it is empty.
+func NewDownloader(request *network.Request, dir string) *Downloader {
+	return &Downloader{
+		request: request,
+		Dir:     dir,
+	}
+}
+
+// LatestVersion returns the client version currently published in version.json.
+func (downloader *Downloader) LatestVersion(ctx context.Context) (string, error) {
+	r := int(rand.Float32()*1e9) + int(rand.Float32()*1e9)
+	body, err := downloader.Get(ctx, fmt.Sprintf("version.json?randv=%d", r))
+	if err != nil {
+		return "", fmt.Errorf("version: %w", err)
+	}
+	version := new(struct {
+		Version string `json:"version"`
+	})
+	if err = json.Unmarshal(body, version); err != nil {
+		return "", fmt.Errorf("version: %w", err)
+	}
+	if len(version.Version) == 0 {
+		return "", fmt.Errorf("version: empty version")
+	}
+	return version.Version, nil
+}
+
+// Latest returns the manifest of the client version currently published.
+func (downloader *Downloader) Latest(ctx context.Context) (*Manifest, error) {
+	version, err := downloader.LatestVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return downloader.Manifest(ctx, version)
+}
+
+// Manifest returns the resversion file of version, from the cache if it was fetched before.
+func (downloader *Downloader) Manifest(ctx context.Context, version string) (*Manifest, error) {
+	name := fmt.Sprintf("resversion%s.json", version)
+	if body, err := downloader.cached(name); err == nil {
+		if manifest, err := parseManifest(version, body); err == nil {
+			return manifest, nil
+		}
+	}
+	body, err := downloader.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("resversion: %w", err)
+	}
+	manifest, err := parseManifest(version, body)
+	if err != nil {
+		return nil, err
+	}
+	if err = downloader.store(name, body); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func parseManifest(version string, body []byte) (*Manifest, error) {
+	manifest := &Manifest{
+		Version: version,
+		Res:     nil,
+	}
+	if err := json.Unmarshal(body, manifest); err != nil {
+		return nil, fmt.Errorf("resversion %s: %w", version, err)
+	}
+	if len(manifest.Res) == 0 {
+		return nil, fmt.Errorf("resversion %s: no resource", version)
+	}
+	return manifest, nil
+}
+
+// Fetch returns the resource at name in the version listed by manifest.
+// The cached copy is used only if it was saved for the same prefix, a downloaded resource is verified before being cached.
+func (downloader *Downloader) Fetch(ctx context.Context, manifest *Manifest, name string) ([]byte, error) {
+	prefix, ok := manifest.Prefix(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotInManifest, name)
+	}
+	entry := manifest.Res[name]
+	key := fmt.Sprintf("%s/%s", prefix, name)
+	if body, err := downloader.cached(key); err == nil && verify(entry, name, body) == nil {
+		return body, nil
+	}
+	body, err := downloader.Get(ctx, manifest.Path(name))
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", key, err)
+	}
+	if err = verify(entry, name, body); err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", key, err)
+	}
+	if err = downloader.store(key, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// Get fetches name from the directory of the web client, without cache nor verification.
+func (downloader *Downloader) Get(ctx context.Context, name string) ([]byte, error) {
+	return downloader.request.GetContext(ctx, fmt.Sprintf("%s/%s", clientPath, name))
+}
+
+// Liqi returns the liqi.json of manifest.
+func (downloader *Downloader) Liqi(ctx context.Context, manifest *Manifest) ([]byte, error) {
+	return downloader.Fetch(ctx, manifest, LiqiPath)
+}
+
+// ConfigTables returns the lqc.lqbin config tables of manifest.
+func (downloader *Downloader) ConfigTables(ctx context.Context, manifest *Manifest) ([]byte, error) {
+	return downloader.Fetch(ctx, manifest, ConfigTablesPath)
+}
+
+// verify rejects bodies that can not be the resource at name listed as entry, like the html page some servers answer
+// with or a truncated download. The size is checked when entry has one, the resversion files of the official servers
+// list only prefixes, so liqi.json and lqc.lqbin are also checked to be a protocol definition and protobuf data.
+func verify(entry Entry, name string, body []byte) error {
+	if len(body) == 0 {
+		return fmt.Errorf("empty resource")
+	}
+	if entry.Size > 0 && int64(len(body)) != entry.Size {
+		return fmt.Errorf("size %d, the manifest lists %d", len(body), entry.Size)
+	}
+	switch {
+	case name == LiqiPath:
+		definition := new(struct {
+			Nested map[string]json.RawMessage `json:"nested"`
+		})
+		if err := json.Unmarshal(body, definition); err != nil {
+			return fmt.Errorf("invalid json: %w", err)
+		}
+		if len(definition.Nested) == 0 {
+			return fmt.Errorf("no package in protocol definition")
+		}
+	case name == ConfigTablesPath:
+		for b := body; len(b) != 0; {
+			_, _, n := protowire.ConsumeField(b)
+			if n < 0 {
+				return fmt.Errorf("invalid protobuf: %w", protowire.ParseError(n))
+			}
+			b = b[n:]
+		}
+	case path.Ext(name) == ".json" && !json.Valid(body):
+		return fmt.Errorf("invalid json")
+	}
+	return nil
+}
+
+// file returns the cache file of key, an error if the cache is disabled or key leaves the cache directory.
+func (downloader *Downloader) file(key string) (string, error) {
+	if len(downloader.Dir) == 0 {
+		return "", os.ErrNotExist
+	}
+	local := filepath.FromSlash(key)
+	if !filepath.IsLocal(local) || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid resource path %q", key)
+	}
+	return filepath.Join(downloader.Dir, local), nil
+}
+
+func (downloader *Downloader) cached(key string) ([]byte, error) {
+	file, err := downloader.file(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(file)
+}
+
+// store saves body as the cache of key, the file is replaced atomically.
+func (downloader *Downloader) store(key string, body []byte) error {
+	if len(downloader.Dir) == 0 {
+		return nil
+	}
+	name, err := downloader.file(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if err = file.Chmod(0o644); err == nil {
+		_, err = file.Write(body)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), name)
+}
@@ -0,0 +1,207 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/constellation39/majsoul/network"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testLiqi     = `{"nested":{"lq":{"nested":{}}}}`
+	testManifest = `{"res":{"res/proto/liqi.json":{"prefix":"v0.10.217.w"},"res/config/lqc.lqbin":{"prefix":"v0.10.200.w","size":4}}}`
+)
+
+// testServer serves the files of a web client and counts the requests of each path.
+type testServer struct {
+	*httptest.Server
+	mutex    sync.Mutex // Guards files and requests
+	files    map[string]string
+	requests map[string]int
+}
+
+func newTestServer(t *testing.T, files map[string]string) *testServer {
+	t.Helper()
+	server := &testServer{files: files, requests: make(map[string]int)}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		server.requests[r.URL.Path]++
+		body, ok := server.files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// served returns the number of requests of path.
+func (server *testServer) served(path string) int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.requests[path]
+}
+
+func (server *testServer) set(path, body string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.files[path] = body
+}
+
+func newTestDownloader(server *testServer, dir string) *Downloader {
+	return NewDownloader(network.NewRequest(server.URL, http.Header{}, *server.Client()), dir)
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestManifest(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/1/version.json":                    `{"version":"0.10.217.w"}`,
+		"/1/resversion0.10.217.w.json":       testManifest,
+		"/1/resversion0.10.218.w.json":       `{"res":{}}`,
+		"/1/resversion0.10.219.w.json":       `<html></html>`,
+		"/1/v0.10.217.w/res/proto/liqi.json": testLiqi,
+	})
+	ctx := testContext(t)
+	dir := t.TempDir()
+	downloader := newTestDownloader(server, dir)
+
+	manifest, err := downloader.Latest(ctx)
+	if err != nil {
+		t.Fatalf("Latest: %v", err)
+	}
+	if manifest.Version != "0.10.217.w" {
+		t.Errorf("Version = %q, want 0.10.217.w", manifest.Version)
+	}
+	if prefix, ok := manifest.Prefix(LiqiPath); !ok || prefix != "v0.10.217.w" {
+		t.Errorf("Prefix(LiqiPath) = %q, %v", prefix, ok)
+	}
+	if path := manifest.Path(LiqiPath); path != "v0.10.217.w/"+LiqiPath {
+		t.Errorf("Path(LiqiPath) = %q", path)
+	}
+	if path := manifest.Path("unknown.json"); path != "unknown.json" {
+		t.Errorf("Path of a resource not listed = %q, want the name", path)
+	}
+
+	if _, err = downloader.Manifest(ctx, "0.10.217.w"); err != nil {
+		t.Fatalf("Manifest: %v", err)
+	}
+	if n := server.served("/1/resversion0.10.217.w.json"); n != 1 {
+		t.Errorf("manifest requested %d times, want 1 then the cache", n)
+	}
+	for _, version := range []string{"0.10.218.w", "0.10.219.w", "0.10.220.w"} {
+		if _, err = downloader.Manifest(ctx, version); err == nil {
+			t.Errorf("Manifest(%s) returned no error", version)
+		}
+		if _, err = os.Stat(filepath.Join(dir, "resversion"+version+".json")); err == nil {
+			t.Errorf("invalid manifest %s was cached", version)
+		}
+	}
+	if _, err = downloader.Fetch(ctx, manifest, "unknown.json"); !errors.Is(err, ErrNotInManifest) {
+		t.Errorf("Fetch of a resource not listed error = %v, want %v", err, ErrNotInManifest)
+	}
+}
+
+func TestFetchCache(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/1/v0.10.217.w/res/proto/liqi.json": testLiqi,
+		"/1/v0.10.218.w/res/proto/liqi.json": testLiqi,
+	})
+	ctx := testContext(t)
+	downloader := newTestDownloader(server, t.TempDir())
+	manifest := &Manifest{Version: "0.10.217.w", Res: map[string]Entry{LiqiPath: {Prefix: "v0.10.217.w"}}}
+
+	for i := 0; i < 2; i++ {
+		body, err := downloader.Liqi(ctx, manifest)
+		if err != nil {
+			t.Fatalf("Liqi: %v", err)
+		}
+		if string(body) != testLiqi {
+			t.Errorf("Liqi = %q, want %q", body, testLiqi)
+		}
+	}
+	if n := server.served("/1/v0.10.217.w/res/proto/liqi.json"); n != 1 {
+		t.Errorf("liqi.json requested %d times, want 1 then the cache", n)
+	}
+
+	// a new prefix misses the cache
+	manifest.Res[LiqiPath] = Entry{Prefix: "v0.10.218.w"}
+	if _, err := downloader.Liqi(ctx, manifest); err != nil {
+		t.Fatalf("Liqi: %v", err)
+	}
+	if n := server.served("/1/v0.10.218.w/res/proto/liqi.json"); n != 1 {
+		t.Errorf("liqi.json of the new prefix requested %d times, want 1", n)
+	}
+}
+
+func TestFetchCorrupt(t *testing.T) {
+	server := newTestServer(t, map[string]string{
+		"/1/v0.10.217.w/res/proto/liqi.json":  `<!DOCTYPE html><html></html>`,
+		"/1/v0.10.200.w/res/config/lqc.lqbin": "\x08\x01",
+	})
+	ctx := testContext(t)
+	dir := t.TempDir()
+	downloader := newTestDownloader(server, dir)
+	manifest, err := parseManifest("0.10.217.w", []byte(testManifest))
+	if err != nil {
+		t.Fatalf("parseManifest: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"html page", `<!DOCTYPE html><html></html>`},
+		{"json of another resource", `{"version":"0.10.217.w"}`},
+		{"empty", ``},
+	}
+	for _, test := range tests {
+		server.set("/1/v0.10.217.w/res/proto/liqi.json", test.body)
+		if _, err = downloader.Liqi(ctx, manifest); err == nil {
+			t.Errorf("Liqi of %s returned no error", test.name)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dir, "v0.10.217.w", filepath.FromSlash(LiqiPath))); err == nil {
+		t.Error("corrupt liqi.json was cached")
+	}
+
+	// the manifest lists 4 bytes
+	if _, err = downloader.ConfigTables(ctx, manifest); err == nil {
+		t.Error("ConfigTables of a truncated download returned no error")
+	}
+	server.set("/1/v0.10.200.w/res/config/lqc.lqbin", "\x0a\x05v")
+	manifest.Res[ConfigTablesPath] = Entry{Prefix: "v0.10.200.w"}
+	if _, err = downloader.ConfigTables(ctx, manifest); err == nil {
+		t.Error("ConfigTables of invalid protobuf returned no error")
+	}
+	server.set("/1/v0.10.200.w/res/config/lqc.lqbin", "\x0a\x02v1")
+	if _, err = downloader.ConfigTables(ctx, manifest); err != nil {
+		t.Errorf("ConfigTables: %v", err)
+	}
+
+	// a corrupt cache file is downloaded again
+	cache := filepath.Join(dir, "v0.10.217.w", filepath.FromSlash(LiqiPath))
+	if err = os.MkdirAll(filepath.Dir(cache), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(cache, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	server.set("/1/v0.10.217.w/res/proto/liqi.json", testLiqi)
+	if body, err := downloader.Liqi(ctx, manifest); err != nil || string(body) != testLiqi {
+		t.Errorf("Liqi with a corrupt cache = %q, %v", body, err)
+	}
+}
@@ -0,0 +1,266 @@
+package majsoul
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/constellation39/majsoul/logger"
+	"github.com/constellation39/majsoul/message"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// restoreTimeout limits a whole login or game restore sequence after a reconnect.
+const restoreTimeout = time.Second * 15
+
+var (
+	// ErrNoGameSession is returned by RestoreGame when the session is not in a game.
+	ErrNoGameSession = errors.New("majsoul: session is not in a game")
+	// ErrTokenRejected is wrapped by the errors of a login refused because of its access token.
+	ErrTokenRejected = errors.New("majsoul: access token rejected")
+)
+
+// session is the login state tracked to restore the connections after a reconnect.
+type session struct {
+	mutex         sync.Mutex // Guards every field
+	authenticated bool       // Logged in on the current lobby connection
+	account       *message.Account
+	accessToken   string
+	connectToken  string
+	gameUuid      string
+}
+
+// loggedIn records a successful Login or Oauth2Login and starts the keepalive.
+func (majSoul *MajSoul) loggedIn(resLogin *message.ResLogin) {
+	majSoul.session.mutex.Lock()
+	majSoul.session.authenticated = true
+	majSoul.session.account = resLogin.Account
+	if len(resLogin.AccessToken) != 0 {
+		majSoul.session.accessToken = resLogin.AccessToken
+	}
+	if resLogin.GameInfo != nil && len(resLogin.GameInfo.GameUuid) != 0 {
+		majSoul.session.connectToken = resLogin.GameInfo.ConnectToken
+		majSoul.session.gameUuid = resLogin.GameInfo.GameUuid
+	} else {
+		majSoul.session.connectToken = ""
+		majSoul.session.gameUuid = ""
+	}
+	majSoul.session.mutex.Unlock()
+	majSoul.startKeepalive()
+	majSoul.refreshState(nil)
+}
+
+// trackSession registers the handlers that keep the game part of the session up to date.
+func (majSoul *MajSoul) trackSession() {
+	On(majSoul, func(majSoul *MajSoul, notify *message.NotifyRoomGameStart) {
+		majSoul.gameStarted(notify.ConnectToken, notify.GameUuid)
+	})
+	On(majSoul, func(majSoul *MajSoul, notify *message.NotifyMatchGameStart) {
+		majSoul.gameStarted(notify.ConnectToken, notify.GameUuid)
+	})
+	On(majSoul, func(majSoul *MajSoul, _ *message.NotifyGameEndResult) {
+		majSoul.gameStarted("", "")
+	})
+	On(majSoul, func(majSoul *MajSoul, _ *message.NotifyGameTerminate) {
+		majSoul.gameStarted("", "")
+	})
+}
+
+func (majSoul *MajSoul) gameStarted(connectToken, gameUuid string) {
+	majSoul.session.mutex.Lock()
+	majSoul.session.connectToken = connectToken
+	majSoul.session.gameUuid = gameUuid
+	majSoul.session.mutex.Unlock()
+	majSoul.refreshState(nil)
+}
+
+// Oauth2Login logs in to the Majsoul server with an access token returned by a previous login.
+func (majSoul *MajSoul) Oauth2Login(ctx context.Context, accessToken string) (*message.ResLogin, error) {
+	if len(accessToken) == 0 {
+		return nil, fmt.Errorf("access token is null")
+	}
+	resLogin, err := majSoul.lobbyClient().Oauth2Login(ctx, &message.ReqOauth2Login{
+		Type:                majSoul.oauth2Type(),
+		AccessToken:         accessToken,
+		Device:              majSoul.deviceInfo(),
+		Reconnect:           false,
+		RandomKey:           majSoul.UUID,
+		ClientVersion:       majSoul.CurrentVersion().clientVersionInfo(),
+		GenAccessToken:      false,
+		CurrencyPlatforms:   majSoul.currencyPlatforms(),
+		ClientVersionString: majSoul.CurrentVersion().Web(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if CheckError(resLogin) == nil {
+		majSoul.loggedIn(resLogin)
+	}
+	return resLogin, nil
+}
+
+// restoreLogin logs in again with the tracked access token.
+func (majSoul *MajSoul) restoreLogin(ctx context.Context) (*message.ResLogin, error) {
+	majSoul.session.mutex.Lock()
+	accessToken := majSoul.session.accessToken
+	majSoul.session.mutex.Unlock()
+	return majSoul.loginWithToken(ctx, accessToken)
+}
+
+// loginWithToken checks accessToken and logs in with it, errors wrap ErrTokenRejected when the server refuses the token.
+func (majSoul *MajSoul) loginWithToken(ctx context.Context, accessToken string) (*message.ResLogin, error) {
+	resOauth2Check, err := majSoul.lobbyClient().Oauth2Check(ctx, &message.ReqOauth2Check{Type: majSoul.oauth2Type(), AccessToken: accessToken})
+	if err != nil {
+		return nil, fmt.Errorf("oauth2 check: %w", err)
+	}
+	if err = CheckError(resOauth2Check); err != nil {
+		return nil, fmt.Errorf("oauth2 check: %w: %w", ErrTokenRejected, err)
+	}
+	if !resOauth2Check.HasAccount {
+		return nil, fmt.Errorf("oauth2 check: %w: access token has no account", ErrTokenRejected)
+	}
+	resLogin, err := majSoul.Oauth2Login(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("oauth2 login: %w", err)
+	}
+	if err = CheckError(resLogin); err != nil {
+		return resLogin, fmt.Errorf("oauth2 login: %w: %w", ErrTokenRejected, err)
+	}
+	return resLogin, nil
+}
+
+// RestoreGame rejoins the game recorded in the session, for example when Login reports an unfinished game.
+// It connects to the game server if there is no game connection yet, then authenticates and synchronizes the game.
+func (majSoul *MajSoul) RestoreGame(ctx context.Context) (*message.ResAuthGame, *message.GameRestore, error) {
+	majSoul.session.mutex.Lock()
+	inGame := len(majSoul.session.gameUuid) != 0
+	majSoul.session.mutex.Unlock()
+	if !inGame {
+		return nil, nil, ErrNoGameSession
+	}
+	if majSoul.gameConn() == nil {
+		if err := majSoul.ConnGame(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	return majSoul.syncGame(ctx)
+}
+
+// syncGame authenticates on the game connection and synchronizes the game state.
+func (majSoul *MajSoul) syncGame(ctx context.Context) (*message.ResAuthGame, *message.GameRestore, error) {
+	majSoul.session.mutex.Lock()
+	reqAuthGame := &message.ReqAuthGame{
+		Token:    majSoul.session.connectToken,
+		GameUuid: majSoul.session.gameUuid,
+	}
+	if majSoul.session.account != nil {
+		reqAuthGame.AccountId = majSoul.session.account.AccountId
+	}
+	majSoul.session.mutex.Unlock()
+
+	fastTestClient := majSoul.gameClient()
+	resAuthGame, err := fastTestClient.AuthGame(ctx, reqAuthGame)
+	if err == nil {
+		err = CheckError(resAuthGame)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("auth game: %w", err)
+	}
+
+	resSyncGame, err := fastTestClient.SyncGame(ctx, &message.ReqSyncGame{RoundId: "-1"})
+	if err == nil {
+		err = CheckError(resSyncGame)
+	}
+	if err != nil {
+		return resAuthGame, nil, fmt.Errorf("sync game: %w", err)
+	}
+
+	resGamePlayerState, err := fastTestClient.FetchGamePlayerState(ctx, &message.ReqCommon{})
+	if err == nil {
+		err = CheckError(resGamePlayerState)
+	}
+	if err != nil {
+		return resAuthGame, resSyncGame.GameRestore, fmt.Errorf("fetch game player state: %w", err)
+	}
+
+	resCommon, err := fastTestClient.FinishSyncGame(ctx, &message.ReqCommon{})
+	if err == nil {
+		err = CheckError(resCommon)
+	}
+	if err != nil {
+		return resAuthGame, resSyncGame.GameRestore, fmt.Errorf("finish sync game: %w", err)
+	}
+	return resAuthGame, resSyncGame.GameRestore, nil
+}
+
+// onLobbyReconnect restores the login, and the game if it has no connection, before calling OnGatewayReconnect.
+func (majSoul *MajSoul) onLobbyReconnect() {
+	majSoul.refreshState(nil)
+
+	majSoul.session.mutex.Lock()
+	recoverable := len(majSoul.session.accessToken) != 0 && !majSoul.config.DisableSessionRecovery
+	majSoul.session.mutex.Unlock()
+
+	if recoverable {
+		ctx, cancel := context.WithTimeout(context.Background(), restoreTimeout)
+		resLogin, err := majSoul.restoreLogin(ctx)
+		if err != nil {
+			logger.Error("majSoul restore login failed", zap.Error(err))
+		}
+		if majSoul.onLobbyRestoredCallBack != nil {
+			majSoul.onLobbyRestoredCallBack(resLogin, err)
+		}
+		if err == nil && majSoul.gameConn() == nil {
+			majSoul.restoreGame(ctx, majSoul.RestoreGame)
+		}
+		cancel()
+	}
+
+	if majSoul.onGatewayReconnectCallBack != nil {
+		majSoul.onGatewayReconnectCallBack()
+	}
+}
+
+// onGameReconnect synchronizes the game again before calling OnGameReconnect.
+func (majSoul *MajSoul) onGameReconnect() {
+	majSoul.session.mutex.Lock()
+	recoverable := len(majSoul.session.gameUuid) != 0 && !majSoul.config.DisableSessionRecovery
+	majSoul.session.mutex.Unlock()
+
+	if recoverable {
+		ctx, cancel := context.WithTimeout(context.Background(), restoreTimeout)
+		majSoul.restoreGame(ctx, majSoul.syncGame)
+		cancel()
+	}
+	majSoul.refreshState(nil)
+
+	if majSoul.onGameReconnectCallBack != nil {
+		majSoul.onGameReconnectCallBack()
+	}
+}
+
+func (majSoul *MajSoul) restoreGame(ctx context.Context, restore func(context.Context) (*message.ResAuthGame, *message.GameRestore, error)) {
+	resAuthGame, gameRestore, err := restore(ctx)
+	if errors.Is(err, ErrNoGameSession) {
+		return
+	}
+	if err != nil {
+		logger.Error("majSoul restore game failed", zap.Error(err))
+	}
+	if majSoul.onGameRestoredCallBack != nil {
+		majSoul.onGameRestoredCallBack(resAuthGame, gameRestore, err)
+	}
+}
+
+// OnLobbyRestored sets the callback reporting the automatic login after the lobby connection is reestablished.
+// resLogin may be nil when err is not nil.
+func (majSoul *MajSoul) OnLobbyRestored(callback func(resLogin *message.ResLogin, err error)) {
+	majSoul.onLobbyRestoredCallBack = callback
+}
+
+// OnGameRestored sets the callback reporting the automatic game resynchronization after a reconnect.
+// gameRestore is the snapshot returned by syncGame, user state should be rebuilt from it.
+func (majSoul *MajSoul) OnGameRestored(callback func(resAuthGame *message.ResAuthGame, gameRestore *message.GameRestore, err error)) {
+	majSoul.onGameRestoredCallBack = callback
+}
@@ -0,0 +1,227 @@
+package majsoul
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/constellation39/majsoul/message"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrNoSavedSession is returned by SessionStore.Load when nothing has been saved.
+var ErrNoSavedSession = errors.New("majsoul: no saved session")
+
+// SavedSession is the part of a login that lets another process resume it without the password.
+type SavedSession struct {
+	AccountId     uint32         `json:"accountId"`
+	AccessToken   string         `json:"accessToken"`
+	UUID          string         `json:"uuid"`   // Random key the access token was issued to
+	Device        *DeviceProfile `json:"device"` // Device the access token was issued to
+	ServerAddress *ServerAddress `json:"serverAddress"`
+	Profile       string         `json:"profile"` // Name of the server profile, empty if the server is not registered
+}
+
+// SessionStore persists the session used by Resume.
+type SessionStore interface {
+	// Load returns the saved session, or ErrNoSavedSession.
+	Load() (*SavedSession, error)
+	// Save replaces the saved session.
+	Save(session *SavedSession) error
+	// Clear removes the saved session.
+	Clear() error
+}
+
+// MemorySessionStore keeps the session in memory, it is safe for concurrent use.
+type MemorySessionStore struct {
+	mutex   sync.Mutex
+	session *SavedSession
+}
+
+// NewMemorySessionStore creates an empty MemorySessionStore.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{
+		session: nil,
+	}
+}
+
+// Load implements SessionStore.
+func (store *MemorySessionStore) Load() (*SavedSession, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.session == nil {
+		return nil, ErrNoSavedSession
+	}
+	session := *store.session
+	return &session, nil
+}
+
+// Save implements SessionStore.
+func (store *MemorySessionStore) Save(session *SavedSession) error {
+	saved := *session
+	store.mutex.Lock()
+	store.session = &saved
+	store.mutex.Unlock()
+	return nil
+}
+
+// Clear implements SessionStore.
+func (store *MemorySessionStore) Clear() error {
+	store.mutex.Lock()
+	store.session = nil
+	store.mutex.Unlock()
+	return nil
+}
+
+// FileSessionStore keeps the session as JSON in a file readable only by its owner.
+type FileSessionStore struct {
+	Path string
+}
+
+// NewFileSessionStore creates a FileSessionStore saving to path.
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{
+		Path: path,
+	}
+}
+
+// Load implements SessionStore.
+func (store *FileSessionStore) Load() (*SavedSession, error) {
+	body, err := os.ReadFile(store.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoSavedSession
+	}
+	if err != nil {
+		return nil, err
+	}
+	session := new(SavedSession)
+	if err = json.Unmarshal(body, session); err != nil {
+		return nil, fmt.Errorf("decode session %s: %w", store.Path, err)
+	}
+	return session, nil
+}
+
+// Save implements SessionStore, the file is replaced atomically.
+func (store *FileSessionStore) Save(session *SavedSession) error {
+	body, err := json.MarshalIndent(session, "", "  ")
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(store.Path), filepath.Base(store.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if err = file.Chmod(0o600); err == nil {
+		_, err = file.Write(body)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), store.Path)
+}
+
+// Clear implements SessionStore.
+func (store *FileSessionStore) Clear() error {
+	err := os.Remove(store.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Resume logs in with the session saved in store, connecting to its server first if there is no lobby connection.
+// Only when the saved access token is rejected, or nothing is saved, it logs in with account and password instead;
+// leave them empty to never send the password. The new session is saved to store after a successful login.
+func (majSoul *MajSoul) Resume(ctx context.Context, store SessionStore, account, password string) (*message.ResLogin, error) {
+	saved, err := store.Load()
+	if err != nil && !errors.Is(err, ErrNoSavedSession) {
+		return nil, fmt.Errorf("load session: %w", err)
+	}
+
+	if saved != nil {
+		if len(saved.UUID) != 0 {
+			majSoul.UUID = saved.UUID
+		}
+		if saved.Device != nil {
+			majSoul.Device = saved.Device
+		}
+		if profile, ok := LookupServerProfile(saved.Profile); ok && majSoul.lobbyClient() == nil {
+			majSoul.Profile = profile
+		}
+	}
+	if majSoul.lobbyClient() == nil {
+		serverAddressList := ServerAddressList
+		if saved != nil && saved.ServerAddress != nil {
+			serverAddressList = []*ServerAddress{saved.ServerAddress}
+		}
+		if err = majSoul.LookupGateway(ctx, serverAddressList); err != nil {
+			return nil, err
+		}
+	}
+
+	var resLogin *message.ResLogin
+	if saved != nil && len(saved.AccessToken) != 0 {
+		resLogin, err = majSoul.loginWithToken(ctx, saved.AccessToken)
+		if err != nil && !errors.Is(err, ErrTokenRejected) {
+			return resLogin, err
+		}
+	} else {
+		err = ErrNoSavedSession
+	}
+	if err != nil {
+		if len(account) == 0 || len(password) == 0 {
+			return resLogin, err
+		}
+		resLogin, err = majSoul.Login(ctx, account, password)
+		if err == nil {
+			err = CheckError(resLogin)
+		}
+		if err != nil {
+			return resLogin, err
+		}
+	}
+
+	if err = store.Save(majSoul.savedSession(resLogin)); err != nil {
+		return resLogin, fmt.Errorf("save session: %w", err)
+	}
+	return resLogin, nil
+}
+
+// SaveSession saves the current login to store, so that a later Resume does not need the password.
+func (majSoul *MajSoul) SaveSession(store SessionStore) error {
+	majSoul.session.mutex.Lock()
+	loggedIn := len(majSoul.session.accessToken) != 0
+	majSoul.session.mutex.Unlock()
+	if !loggedIn {
+		return fmt.Errorf("not logged in")
+	}
+	return store.Save(majSoul.savedSession(nil))
+}
+
+func (majSoul *MajSoul) savedSession(resLogin *message.ResLogin) *SavedSession {
+	majSoul.session.mutex.Lock()
+	defer majSoul.session.mutex.Unlock()
+	saved := &SavedSession{
+		AccountId:     0,
+		AccessToken:   majSoul.session.accessToken,
+		UUID:          majSoul.UUID,
+		Device:        majSoul.Device,
+		ServerAddress: majSoul.ServerAddress,
+		Profile:       "",
+	}
+	if majSoul.Profile != nil {
+		saved.Profile = majSoul.Profile.Name
+	}
+	if resLogin != nil {
+		saved.AccountId = resLogin.AccountId
+	} else if majSoul.session.account != nil {
+		saved.AccountId = majSoul.session.account.AccountId
+	}
+	return saved
+}
@@ -0,0 +1,113 @@
+package majsoul
+
+import (
+	"fmt"
+	"github.com/constellation39/majsoul/network"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"nhooyr.io/websocket"
+	"sync"
+	"time"
+)
+
+// clients holds the transport and cookie jar shared by the HTTP requests and websockets of a MajSoul,
+// so that they share one connection pool and the game connection gets the cookies of the lobby.
+type clients struct {
+	mutex     sync.Mutex // Guards every field
+	transport http.RoundTripper
+	jar       http.CookieJar
+}
+
+// WithRoundTripper sets the transport of HTTP requests and websocket handshakes, e.g. the one of an httptest.Server.
+func WithRoundTripper(roundTripper http.RoundTripper) Option {
+	return func(config *Config) {
+		config.RoundTripper = roundTripper
+	}
+}
+
+// httpClient returns a client with the shared transport and cookie jar, built on first use.
+func (majSoul *MajSoul) httpClient(timeout time.Duration) (*http.Client, error) {
+	majSoul.clients.mutex.Lock()
+	defer majSoul.clients.mutex.Unlock()
+	if majSoul.clients.transport == nil {
+		transport := majSoul.config.RoundTripper
+		if transport == nil {
+			t, err := majSoul.newTransport()
+			if err != nil {
+				return nil, err
+			}
+			transport = t
+		}
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, fmt.Errorf("cookie jar error %v", err)
+		}
+		majSoul.clients.transport = transport
+		majSoul.clients.jar = jar
+	}
+	return &http.Client{
+		Transport:     majSoul.clients.transport,
+		CheckRedirect: nil,
+		Jar:           majSoul.clients.jar,
+		Timeout:       timeout,
+	}, nil
+}
+
+// closeIdleConnections closes the idle connections of the shared transport.
+func (majSoul *MajSoul) closeIdleConnections() {
+	majSoul.clients.mutex.Lock()
+	transport := majSoul.clients.transport
+	majSoul.clients.mutex.Unlock()
+	if closer, ok := transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// header returns the headers of the browser for requests to host sent from the page at origin.
+func (majSoul *MajSoul) header(host, origin string) http.Header {
+	header := http.Header{}
+	header.Add("Accept-Encoding", network.AcceptEncoding)
+	header.Add("Accept-Language", majSoul.config.acceptLanguage())
+	header.Add("Cache-Control", "no-cache")
+	header.Add("Host", host)
+	header.Add("Origin", origin)
+	header.Add("Pragma", "no-cache")
+	header.Add("User-Agent", majSoul.config.userAgent())
+	return header
+}
+
+// newRequest returns a Request to baseURL sent from the page at origin.
+func (majSoul *MajSoul) newRequest(baseURL, origin string) (*network.Request, error) {
+	connUrl, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse url error %v", err)
+	}
+	httpClient := majSoul.config.HTTPClient
+	if httpClient == nil {
+		httpClient, err = majSoul.httpClient(majSoul.config.requestTimeout())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return network.NewRequest(baseURL, majSoul.header(connUrl.Host, origin), *httpClient), nil
+}
+
+// newWsClient returns a WsClient to address opened from the page at origin, it is not connected yet.
+func (majSoul *MajSoul) newWsClient(address, origin string) (*network.WsClient, error) {
+	connUrl, err := url.Parse(origin)
+	if err != nil {
+		return nil, fmt.Errorf("parse url error %v", err)
+	}
+	httpClient, err := majSoul.httpClient(majSoul.config.dialTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return network.NewWsClient(address, websocket.DialOptions{
+		HTTPClient:           httpClient,
+		HTTPHeader:           majSoul.header(connUrl.Host, origin),
+		Subprotocols:         nil,
+		CompressionMode:      0,
+		CompressionThreshold: 0,
+	}, majSoul.wsOptions()...), nil
+}
@@ -0,0 +1,52 @@
+package majsoul
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingRoundTripper counts the requests sent through it.
+type countingRoundTripper struct {
+	transport http.RoundTripper
+	requests  int32 // accessed atomically
+}
+
+func (c *countingRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&c.requests, 1)
+	return c.transport.RoundTrip(r)
+}
+
+func TestRoundTripperSharesCookieJar(t *testing.T) {
+	server := newTestServer(t)
+	server.onVersion = func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "lobby", Path: "/"})
+	}
+	cookies := make(chan string, 1)
+	server.onGateway = func(r *http.Request) {
+		var value string
+		if cookie, err := r.Cookie("session"); err == nil {
+			value = cookie.Value
+		}
+		cookies <- value
+	}
+	roundTripper := &countingRoundTripper{transport: server.Client().Transport}
+	majSoul := newTestMajSoul(t, server, WithRoundTripper(roundTripper))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if err := majSoul.LookupGateway(ctx, []*ServerAddress{server.address()}); err != nil {
+		t.Fatalf("LookupGateway: %v", err)
+	}
+	if cookie := <-cookies; cookie != "lobby" {
+		t.Errorf("handshake cookie = %q, want %q", cookie, "lobby")
+	}
+	if requests := atomic.LoadInt32(&roundTripper.requests); requests != 2 {
+		t.Errorf("requests through the round tripper = %d, want 2, the version fetch and the handshake", requests)
+	}
+	if majSoul.CurrentVersion().Version != "0.10.217.w" {
+		t.Errorf("version = %+v", majSoul.CurrentVersion())
+	}
+}
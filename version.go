@@ -0,0 +1,348 @@
+package majsoul
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/constellation39/majsoul/logger"
+	"github.com/constellation39/majsoul/message"
+	"github.com/constellation39/majsoul/network"
+	"github.com/constellation39/majsoul/resources"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ProtoVersion is the client version liqi.proto was generated from,
+	// cmd/liqigen writes it to message/version.go when it downloads liqi.json.
+	ProtoVersion = message.ProtoVersion
+
+	defaultVersionInterval = time.Minute * 10 // Used when Config.VersionInterval is 0
+)
+
+// Version represents the version information for the client.
+type Version struct {
+	Version      string `json:"version"`
+	ForceVersion string `json:"force_version"`
+	Code         string `json:"code"`
+}
+
+// Web return version web format
+// field Version "0.10.113.w"
+// return "web-0.10.113"
+// An empty string is returned for a nil Version, a version that can not be parsed is returned unchanged after "web-".
+func (v *Version) Web() string {
+	if v == nil || len(v.Version) == 0 {
+		return ""
+	}
+	number, err := ParseVersionNumber(v.Version)
+	if err != nil {
+		return fmt.Sprintf("web-%s", v.Version)
+	}
+	return fmt.Sprintf("web-%d.%d.%d", number.Major, number.Minor, number.Patch)
+}
+
+// ProtoOutdated reports whether the server forces a client newer than the one liqi.proto was generated from,
+// in which case some messages may not be understood by either side.
+func (v *Version) ProtoOutdated() bool {
+	if v == nil {
+		return false
+	}
+	force, err := ParseVersionNumber(v.ForceVersion)
+	if err != nil {
+		return false
+	}
+	return force.Compare(protoVersion) > 0
+}
+
+// clientVersionInfo returns the client_version sent on login.
+func (v *Version) clientVersionInfo() *message.ClientVersionInfo {
+	info := &message.ClientVersionInfo{
+		Resource: "",
+		Package:  "",
+	}
+	if v != nil {
+		info.Resource = v.Version
+	}
+	return info
+}
+
+// hasVersionFields reports whether messages of descriptor have a field set by Version.apply.
+func hasVersionFields(descriptor protoreflect.MessageDescriptor) bool {
+	fields := descriptor.Fields()
+	return fields.ByName("client_version_string") != nil || fields.ByName("client_version") != nil
+}
+
+// apply sets the client version fields of a request to v.
+// client_version_string is replaced, the resource of client_version is replaced when the field is set.
+func (v *Version) apply(request protoreflect.Message) {
+	if v == nil || len(v.Version) == 0 {
+		return
+	}
+	fields := request.Descriptor().Fields()
+	if field := fields.ByName("client_version_string"); field != nil && !field.IsList() && field.Kind() == protoreflect.StringKind {
+		request.Set(field, protoreflect.ValueOfString(v.Web()))
+	}
+	if field := fields.ByName("client_version"); field != nil && !field.IsList() && field.Kind() == protoreflect.MessageKind && request.Has(field) {
+		info := request.Mutable(field).Message()
+		if resource := info.Descriptor().Fields().ByName("resource"); resource != nil && !resource.IsList() && resource.Kind() == protoreflect.StringKind {
+			info.Set(resource, protoreflect.ValueOfString(v.Version))
+		}
+	}
+}
+
+// VersionNumber is a parsed client version such as "0.10.113.w".
+// Two numbers are equal when their fields are, use Compare to order them.
+type VersionNumber struct {
+	Major  int
+	Minor  int
+	Patch  int
+	Suffix string // Platform of the client, "w" for the web client
+}
+
+// protoVersion is ProtoVersion parsed.
+var protoVersion, _ = ParseVersionNumber(ProtoVersion)
+
+// ParseVersionNumber parses a version of version.json, "0.10.113.w", a leading "v" or "web-" is accepted.
+func ParseVersionNumber(version string) (VersionNumber, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(version, "web-"), "v")
+	parts := strings.SplitN(trimmed, ".", 4)
+	if len(parts) < 3 {
+		return VersionNumber{}, fmt.Errorf("parse version %q error: want major.minor.patch", version)
+	}
+	numbers := make([]int, 3)
+	for i := range numbers {
+		number, err := strconv.Atoi(parts[i])
+		if err != nil || number < 0 {
+			return VersionNumber{}, fmt.Errorf("parse version %q error: invalid number %q", version, parts[i])
+		}
+		numbers[i] = number
+	}
+	number := VersionNumber{
+		Major:  numbers[0],
+		Minor:  numbers[1],
+		Patch:  numbers[2],
+		Suffix: "",
+	}
+	if len(parts) == 4 {
+		number.Suffix = parts[3]
+	}
+	return number, nil
+}
+
+// Compare returns -1, 0 or +1 depending on whether n is older, the same or newer than other.
+// The suffix is not compared.
+func (n VersionNumber) Compare(other VersionNumber) int {
+	switch {
+	case n.Major != other.Major:
+		return compareInt(n.Major, other.Major)
+	case n.Minor != other.Minor:
+		return compareInt(n.Minor, other.Minor)
+	default:
+		return compareInt(n.Patch, other.Patch)
+	}
+}
+
+func (n VersionNumber) String() string {
+	if len(n.Suffix) == 0 {
+		return fmt.Sprintf("%d.%d.%d", n.Major, n.Minor, n.Patch)
+	}
+	return fmt.Sprintf("%d.%d.%d.%s", n.Major, n.Minor, n.Patch, n.Suffix)
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// VersionChanged reports a new version.json found by the version watcher.
+type VersionChanged struct {
+	Old           *Version // Version used until now
+	New           *Version // Version used from now on by every request
+	ForceUpdate   bool     // The force_version of New is newer than Old, requests made with Old are refused
+	ProtoOutdated bool     // The force_version of New is newer than ProtoVersion, see Version.ProtoOutdated
+}
+
+// versionWatcher holds the version in use and the state of the version.json poller.
+type versionWatcher struct {
+	mutex  sync.RWMutex // Guards MajSoul.Version and cancel
+	cancel context.CancelFunc
+}
+
+// versionInterval returns the interval of version.json polling, 0 if polling is disabled.
+func (majSoul *MajSoul) versionInterval() time.Duration {
+	switch {
+	case majSoul.config.VersionInterval < 0:
+		return 0
+	case majSoul.config.VersionInterval == 0:
+		return defaultVersionInterval
+	default:
+		return majSoul.config.VersionInterval
+	}
+}
+
+// CurrentVersion returns the version sent with requests, nil before LookupGateway.
+// Use it instead of the Version field while the version watcher may be running.
+func (majSoul *MajSoul) CurrentVersion() *Version {
+	majSoul.watcher.mutex.RLock()
+	defer majSoul.watcher.mutex.RUnlock()
+	return majSoul.Version
+}
+
+// setVersion replaces the version in use.
+func (majSoul *MajSoul) setVersion(version *Version) {
+	majSoul.watcher.mutex.Lock()
+	majSoul.Version = version
+	majSoul.watcher.mutex.Unlock()
+}
+
+// OnVersionChanged sets the callback called when the version watcher finds a new version.json.
+func (majSoul *MajSoul) OnVersionChanged(callback func(changed VersionChanged)) {
+	majSoul.onVersionChangedCallBack = callback
+}
+
+// startVersionWatcher starts polling version.json through request, replacing a running watcher.
+func (majSoul *MajSoul) startVersionWatcher(request *network.Request) {
+	interval := majSoul.versionInterval()
+	if interval == 0 {
+		return
+	}
+	parent := majSoul.config.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+
+	majSoul.watcher.mutex.Lock()
+	if majSoul.watcher.cancel != nil {
+		majSoul.watcher.cancel()
+	}
+	majSoul.watcher.cancel = cancel
+	majSoul.watcher.mutex.Unlock()
+
+	go majSoul.runVersionWatcher(ctx, request, interval)
+}
+
+// stopVersionWatcher stops the running watcher, if any.
+func (majSoul *MajSoul) stopVersionWatcher() {
+	majSoul.watcher.mutex.Lock()
+	defer majSoul.watcher.mutex.Unlock()
+	if majSoul.watcher.cancel != nil {
+		majSoul.watcher.cancel()
+		majSoul.watcher.cancel = nil
+	}
+}
+
+func (majSoul *MajSoul) runVersionWatcher(ctx context.Context, request *network.Request, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		version, err := fetchVersion(ctx, request)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Warn("majSoul fetch version failed", zap.Error(err))
+			continue
+		}
+		majSoul.updateVersion(version)
+	}
+}
+
+// updateVersion makes version the one in use and calls OnVersionChanged if it differs from the previous one.
+func (majSoul *MajSoul) updateVersion(version *Version) {
+	majSoul.watcher.mutex.Lock()
+	old := majSoul.Version
+	if old != nil && *old == *version {
+		majSoul.watcher.mutex.Unlock()
+		return
+	}
+	majSoul.Version = version
+	majSoul.watcher.mutex.Unlock()
+
+	changed := VersionChanged{
+		Old:           old,
+		New:           version,
+		ForceUpdate:   forceUpdate(old, version),
+		ProtoOutdated: version.ProtoOutdated(),
+	}
+	logger.Info("majSoul version changed", zap.String("version", version.Version), zap.String("forceVersion", version.ForceVersion), zap.Bool("forceUpdate", changed.ForceUpdate))
+	if changed.ProtoOutdated {
+		logger.Warn("majSoul force version is newer than liqi.proto", zap.String("forceVersion", version.ForceVersion), zap.String("protoVersion", ProtoVersion))
+	}
+	if majSoul.onVersionChangedCallBack != nil {
+		majSoul.onVersionChangedCallBack(changed)
+	}
+}
+
+// forceUpdate reports whether the force_version of version is newer than the version of old.
+func forceUpdate(old, version *Version) bool {
+	if old == nil {
+		return false
+	}
+	current, err := ParseVersionNumber(old.Version)
+	if err != nil {
+		return false
+	}
+	force, err := ParseVersionNumber(version.ForceVersion)
+	if err != nil {
+		return false
+	}
+	return force.Compare(current) > 0
+}
+
+// versionInterceptor sends every request with its client version fields set to the current version,
+// so that requests built with an older Version, like ReqJoinRoom or ReqStartUnifiedMatch, are not refused after an update.
+// The fields are set in a copy, the request of the caller is not modified.
+func (majSoul *MajSoul) versionInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if request, ok := req.(proto.Message); ok && hasVersionFields(request.ProtoReflect().Descriptor()) {
+		if version := majSoul.CurrentVersion(); version != nil && len(version.Version) != 0 {
+			request = proto.Clone(request)
+			version.apply(request.ProtoReflect())
+			req = request
+		}
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// Resources returns a downloader of the resources of the server in use, caching to dir unless it is empty.
+// LookupGateway must have succeeded, the manifest of the version in use is the one of CurrentVersion.
+func (majSoul *MajSoul) Resources(dir string) (*resources.Downloader, error) {
+	if majSoul.Request == nil {
+		return nil, fmt.Errorf("no server, LookupGateway first")
+	}
+	return resources.NewDownloader(majSoul.Request, dir), nil
+}
+
+// fetchVersion fetches version.json through request.
+func fetchVersion(ctx context.Context, request *network.Request) (*Version, error) {
+	r := int(rand.Float32()*1e9) + int(rand.Float32()*1e9)
+	body, err := request.GetContext(ctx, fmt.Sprintf("1/version.json?randv=%d", r))
+	if err != nil {
+		return nil, err
+	}
+	version := new(Version)
+	err = json.Unmarshal(body, version)
+	if err != nil {
+		return nil, err
+	}
+	return version, nil
+}
@@ -0,0 +1,46 @@
+package majsoul
+
+import (
+	"context"
+	"github.com/constellation39/majsoul/message"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+func TestVersionInterceptorCopiesRequest(t *testing.T) {
+	majSoul := NewMajSoul(&Config{KeepaliveInterval: -1, VersionInterval: -1})
+	majSoul.setVersion(&Version{Version: "0.10.300.w"})
+
+	in := &message.ReqLogin{
+		Account:             "account",
+		ClientVersion:       &message.ClientVersionInfo{Resource: "0.10.217.w"},
+		ClientVersionString: "web-0.10.217",
+	}
+	var sent *message.ReqLogin
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		sent = req.(*message.ReqLogin)
+		return nil
+	}
+	if err := majSoul.versionInterceptor(context.Background(), "/lq.Lobby/login", in, &message.ResLogin{}, nil, invoker); err != nil {
+		t.Fatalf("versionInterceptor: %v", err)
+	}
+
+	if sent.ClientVersionString != "web-0.10.300" || sent.ClientVersion.GetResource() != "0.10.300.w" {
+		t.Errorf("sent version = %q, %q, want the current version", sent.ClientVersionString, sent.ClientVersion.GetResource())
+	}
+	if sent.Account != "account" {
+		t.Errorf("sent account = %q, want the one of the request", sent.Account)
+	}
+	if in.ClientVersionString != "web-0.10.217" || in.ClientVersion.Resource != "0.10.217.w" {
+		t.Errorf("request of the caller was modified to %q, %q", in.ClientVersionString, in.ClientVersion.Resource)
+	}
+
+	heartbeat := &message.ReqHeatBeat{}
+	invoker = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if req != heartbeat {
+			t.Error("request without version fields was copied")
+		}
+		return nil
+	}
+	_ = majSoul.versionInterceptor(context.Background(), "/lq.Lobby/heatbeat", heartbeat, &message.ResCommon{}, nil, invoker)
+}